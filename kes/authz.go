@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kes
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+)
+
+// CertificateIdentity returns the KES identity of the X.509
+// certificate. It is the hex-encoded SHA-256 hash of the
+// certificate's public key, the same identity a KES server
+// computes for its clients.
+func CertificateIdentity(cert *x509.Certificate) Identity {
+	id := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return Identity(hex.EncodeToString(id[:]))
+}
+
+// PeerIdentity returns the KES identity of the peer's leaf
+// certificate of the TLS connection state. It returns
+// IdentityUnknown if the peer has not sent a certificate.
+func PeerIdentity(state *tls.ConnectionState) Identity {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return IdentityUnknown
+	}
+	return CertificateIdentity(state.PeerCertificates[0])
+}
+
+// Authorizer is an HTTP middleware that controls access to
+// an http.Handler with KES policies. It allows operators of
+// other HTTP services to reuse the KES policy model.
+//
+// For every request, the Authorizer computes the KES identity
+// of the client's mTLS certificate, looks up its Policy and
+// verifies the request with Policy.Verify. If the client has
+// not sent a certificate, has no policy or the policy rejects
+// the request, the Authorizer responds with 403 Forbidden and
+// the JSON error body of ErrNotAllowed, as a KES server does.
+type Authorizer struct {
+	// Handler is the handler invoked for authorized requests.
+	Handler http.Handler
+
+	// Policy returns the policy associated to the identity
+	// and true, or false if the identity has no policy.
+	Policy func(Identity) (*Policy, bool)
+
+	// Audit is an optional function called with an AuditEvent
+	// once the request has been handled, either by responding
+	// with 403 Forbidden or by the Handler.
+	Audit func(AuditEvent)
+}
+
+// ServeHTTP verifies the request and either rejects it or
+// invokes the Authorizer's Handler.
+func (a *Authorizer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	identity := PeerIdentity(r.TLS)
+
+	aw := &auditResponseWriter{ResponseWriter: w}
+	if a.verify(identity, r) != nil {
+		writeError(aw, ErrNotAllowed)
+	} else {
+		a.Handler.ServeHTTP(aw, r)
+	}
+
+	if a.Audit != nil {
+		status := aw.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		a.Audit(AuditEvent{
+			Timestamp:      start,
+			APIPath:        r.URL.Path,
+			ClientIP:       clientIP(r),
+			ClientIdentity: identity,
+			StatusCode:     status,
+			ResponseTime:   time.Since(start),
+		})
+	}
+}
+
+func (a *Authorizer) verify(identity Identity, r *http.Request) error {
+	if identity.IsUnknown() || a.Policy == nil {
+		return ErrNotAllowed
+	}
+	policy, ok := a.Policy(identity)
+	if !ok || policy == nil {
+		return ErrNotAllowed
+	}
+	return policy.Verify(r)
+}
+
+// writeError writes err as KES JSON error response to w.
+func writeError(w http.ResponseWriter, err Error) {
+	type Response struct {
+		Message string `json:"message"`
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.Status())
+	json.NewEncoder(w).Encode(Response{Message: err.Error()})
+}
+
+// clientIP returns the IP address of the client
+// that sent the request, or nil if unknown.
+func clientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
+// auditResponseWriter is an http.ResponseWriter that
+// records the response status code.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *auditResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying http.ResponseWriter.
+// It is used by http.ResponseController.
+func (w *auditResponseWriter) Unwrap() http.ResponseWriter { return w.ResponseWriter }
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kes
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAuthorizer(t *testing.T) {
+	cert := newClientCertificate(t)
+	identity := CertificateIdentity(cert.Leaf)
+
+	var (
+		mu     sync.Mutex
+		events []AuditEvent
+	)
+	server := httptest.NewUnstartedServer(&Authorizer{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+		}),
+		Policy: func(id Identity) (*Policy, bool) {
+			if id != identity {
+				return nil, false
+			}
+			return &Policy{
+				Allow: map[string]Rule{"/v1/key/encrypt/app-*": {}},
+				Deny:  map[string]Rule{"/v1/key/encrypt/app-secret": {}},
+			}, true
+		},
+		Audit: func(event AuditEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+		},
+	})
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	for i, test := range authorizerTests {
+		client := server.Client()
+		if test.WithCert {
+			client.Transport.(*http.Transport).TLSClientConfig.Certificates = []tls.Certificate{cert}
+		} else {
+			client.Transport.(*http.Transport).TLSClientConfig.Certificates = nil
+		}
+		client.Transport.(*http.Transport).CloseIdleConnections()
+
+		resp, err := client.Post(server.URL+test.Path, "application/octet-stream", nil)
+		if err != nil {
+			t.Fatalf("Test %d: failed to send request: %v", i, err)
+		}
+		if resp.StatusCode != test.StatusCode {
+			t.Fatalf("Test %d: status code mismatch: got '%d' - want '%d'", i, resp.StatusCode, test.StatusCode)
+		}
+		if test.StatusCode == http.StatusForbidden {
+			if err = parseErrorResponse(resp); !errors.Is(err, ErrNotAllowed) {
+				t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, ErrNotAllowed)
+			}
+		}
+		resp.Body.Close()
+	}
+
+	server.Close() // Wait for all handlers, and audit functions, to return
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != len(authorizerTests) {
+		t.Fatalf("Got %d audit events - want %d", len(events), len(authorizerTests))
+	}
+
+	for i, test := range authorizerTests {
+		event := events[i]
+		if event.APIPath != test.Path || event.StatusCode != test.StatusCode {
+			t.Fatalf("Test %d: audit event mismatch: got '%s %d' - want '%s %d'", i, event.APIPath, event.StatusCode, test.Path, test.StatusCode)
+		}
+		if test.WithCert && event.ClientIdentity != identity {
+			t.Fatalf("Test %d: identity mismatch: got '%s' - want '%s'", i, event.ClientIdentity, identity)
+		}
+		if !test.WithCert && !event.ClientIdentity.IsUnknown() {
+			t.Fatalf("Test %d: identity mismatch: got '%s' - want unknown identity", i, event.ClientIdentity)
+		}
+		if event.ClientIP == nil {
+			t.Fatalf("Test %d: audit event has no client IP", i)
+		}
+		if event.Timestamp.IsZero() || event.ResponseTime < 0 {
+			t.Fatalf("Test %d: invalid audit event timing: got '%v' and '%v'", i, event.Timestamp, event.ResponseTime)
+		}
+	}
+}
+
+var authorizerTests = []struct {
+	Path       string
+	WithCert   bool
+	StatusCode int
+}{
+	{Path: "/v1/key/encrypt/app-1", WithCert: true, StatusCode: http.StatusAccepted},
+	{Path: "/v1/key/encrypt/app-secret", WithCert: true, StatusCode: http.StatusForbidden},
+	{Path: "/v1/key/decrypt/app-1", WithCert: true, StatusCode: http.StatusForbidden},
+	{Path: "/v1/key/encrypt/app-1", WithCert: false, StatusCode: http.StatusForbidden},
+}
+
+func newClientCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(raw)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+	return tls.Certificate{Certificate: [][]byte{raw}, PrivateKey: key, Leaf: leaf}
+}
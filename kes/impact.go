@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kes
+
+import (
+	"cmp"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// PolicyImpact describes the requests of one identity to one
+// KES API that have been allowed in the past but would be
+// rejected by a proposed policy.
+type PolicyImpact struct {
+	Identity Identity     // The identity that sent the requests
+	API      string       // The API path without arguments. For example, "/v1/key/decrypt"
+	Events   []AuditEvent // All rejected audit events in the order they occurred
+}
+
+// AnalyzePolicy replays the audit events from the stream and
+// reports which of the requests, that were allowed in the past,
+// would be rejected by the proposed policy. It reads the stream
+// until the end and closes it.
+//
+// Only events of the given identities are evaluated. Usually,
+// these are the identities the policy is or will be assigned to.
+// Events of requests that have been rejected in the past, with a
+// 403 Forbidden status code, are ignored.
+//
+// The returned PolicyImpacts are grouped by identity and API and
+// sorted in ascending order. If the proposed policy would not
+// reject any previously allowed request, AnalyzePolicy returns
+// an empty slice.
+func AnalyzePolicy(policy *Policy, stream *AuditStream, identities ...Identity) ([]PolicyImpact, error) {
+	type Group struct {
+		Identity Identity
+		API      string
+	}
+
+	groups := map[Group][]AuditEvent{}
+	for stream.Next() {
+		event := stream.Event()
+		if !slices.Contains(identities, event.ClientIdentity) {
+			continue
+		}
+		if event.StatusCode == http.StatusForbidden {
+			continue
+		}
+
+		u, err := url.Parse(event.APIPath)
+		if err != nil {
+			stream.Close()
+			return nil, err
+		}
+		if policy.Verify(&http.Request{URL: u}) == nil {
+			continue
+		}
+
+		g := Group{Identity: event.ClientIdentity, API: apiPath(u.Path)}
+		groups[g] = append(groups[g], event)
+	}
+	// Once closed, Close returns the first error encountered
+	// while reading the stream, if any.
+	stream.Close()
+	if err := stream.Close(); err != nil {
+		return nil, err
+	}
+
+	impacts := make([]PolicyImpact, 0, len(groups))
+	for g, events := range groups {
+		impacts = append(impacts, PolicyImpact{
+			Identity: g.Identity,
+			API:      g.API,
+			Events:   events,
+		})
+	}
+	slices.SortFunc(impacts, func(a, b PolicyImpact) int {
+		if n := cmp.Compare(a.Identity, b.Identity); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.API, b.API)
+	})
+	return impacts, nil
+}
+
+// apiPath returns the API of the URL path p without any
+// API arguments. For example, "/v1/key/decrypt" for
+// "/v1/key/decrypt/my-key".
+func apiPath(p string) string {
+	const N = 3 // version, resource and operation. For example, v1, key and decrypt
+	elems := strings.SplitN(strings.TrimPrefix(p, "/"), "/", N+1)
+	if len(elems) > N {
+		elems = elems[:N]
+	}
+	return "/" + strings.Join(elems, "/")
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kes
+
+import (
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestAnalyzePolicy(t *testing.T) {
+	const (
+		Alice Identity = "3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22"
+		Bob   Identity = "ba2cc7d4cd1a8b9b8fa0b4e3b8eec7e4b66e3b7a8ee41b3bb5c7cf3a0b4ac1b6"
+	)
+	events := strings.Join([]string{
+		`{"time":"2025-01-01T00:00:00Z","request":{"path":"/v1/key/decrypt/my-key","identity":"` + string(Alice) + `"},"response":{"code":200}}`,
+		`{"time":"2025-01-01T00:00:01Z","request":{"path":"/v1/key/decrypt/other-key","identity":"` + string(Alice) + `"},"response":{"code":200}}`,
+		`{"time":"2025-01-01T00:00:02Z","request":{"path":"/v1/key/generate/other-key","identity":"` + string(Alice) + `"},"response":{"code":200}}`,
+		`{"time":"2025-01-01T00:00:03Z","request":{"path":"/v1/key/create/my-key","identity":"` + string(Alice) + `"},"response":{"code":403}}`,
+		`{"time":"2025-01-01T00:00:04Z","request":{"path":"/v1/key/decrypt/other-key","identity":"` + string(Bob) + `"},"response":{"code":200}}`,
+		`{"time":"2025-01-01T00:00:05Z","request":{"path":"/v1/key/generate/other-key","identity":"` + string(Alice) + `"},"response":{"code":404}}`,
+	}, "\n")
+
+	for i, test := range analyzePolicyTests {
+		impacts, err := AnalyzePolicy(test.Policy, NewAuditStream(strings.NewReader(events)), test.Identities...)
+		if err != nil {
+			t.Fatalf("Test %d: failed to analyze policy: %v", i, err)
+		}
+		if len(impacts) != len(test.Impacts) {
+			t.Fatalf("Test %d: got %d impacts - want %d", i, len(impacts), len(test.Impacts))
+		}
+		for j, impact := range impacts {
+			var paths []string
+			for _, event := range impact.Events {
+				paths = append(paths, event.APIPath)
+			}
+
+			want := test.Impacts[j]
+			if impact.Identity != want.Identity || impact.API != want.API || !slices.Equal(paths, want.Paths) {
+				t.Fatalf("Test %d: impact %d mismatch: got '%s %s %v' - want '%s %s %v'", i, j, impact.Identity, impact.API, paths, want.Identity, want.API, want.Paths)
+			}
+		}
+	}
+}
+
+func TestAnalyzePolicy_InvalidStream(t *testing.T) {
+	const Alice Identity = "3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22"
+
+	// The stream has a closer. AnalyzePolicy must report the
+	// decoding error even though closing the stream succeeds.
+	stream := NewAuditStream(io.NopCloser(strings.NewReader(`{"time":"2025-01-01T00:00:00Z"}` + "\n{")))
+	if _, err := AnalyzePolicy(&Policy{}, stream, Alice); err == nil {
+		t.Fatal("Analyzed policy from invalid audit stream")
+	}
+}
+
+var analyzePolicyTests = []struct {
+	Policy     *Policy
+	Identities []Identity
+	Impacts    []struct {
+		Identity Identity
+		API      string
+		Paths    []string
+	}
+}{
+	{ // 0
+		Policy: &Policy{Allow: map[string]Rule{"/v1/key/*": {}}},
+		Identities: []Identity{
+			"3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22",
+		},
+	},
+	{ // 1
+		Policy: &Policy{
+			Allow: map[string]Rule{"/v1/key/*": {}},
+			Deny:  map[string]Rule{"/v1/key/decrypt/other-key": {}, "/v1/key/generate/other-key": {}},
+		},
+		Identities: []Identity{
+			"3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22",
+			"ba2cc7d4cd1a8b9b8fa0b4e3b8eec7e4b66e3b7a8ee41b3bb5c7cf3a0b4ac1b6",
+		},
+		Impacts: []struct {
+			Identity Identity
+			API      string
+			Paths    []string
+		}{
+			{
+				Identity: "3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22",
+				API:      "/v1/key/decrypt",
+				Paths:    []string{"/v1/key/decrypt/other-key"},
+			},
+			{
+				Identity: "3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22",
+				API:      "/v1/key/generate",
+				Paths:    []string{"/v1/key/generate/other-key", "/v1/key/generate/other-key"},
+			},
+			{
+				Identity: "ba2cc7d4cd1a8b9b8fa0b4e3b8eec7e4b66e3b7a8ee41b3bb5c7cf3a0b4ac1b6",
+				API:      "/v1/key/decrypt",
+				Paths:    []string{"/v1/key/decrypt/other-key"},
+			},
+		},
+	},
+	{ // 2
+		Policy: &Policy{Allow: map[string]Rule{"/v1/key/decrypt/my-key": {}}},
+		Identities: []Identity{
+			"3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22",
+		},
+		Impacts: []struct {
+			Identity Identity
+			API      string
+			Paths    []string
+		}{
+			{
+				Identity: "3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22",
+				API:      "/v1/key/decrypt",
+				Paths:    []string{"/v1/key/decrypt/other-key"},
+			},
+			{
+				Identity: "3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22",
+				API:      "/v1/key/generate",
+				Paths:    []string{"/v1/key/generate/other-key", "/v1/key/generate/other-key"},
+			},
+		},
+	},
+}
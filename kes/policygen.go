@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kes
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// PolicyGenerator generates least-privilege policies from audit
+// events. A generated policy allows exactly the requests an
+// identity has sent within a time window, and nothing more.
+type PolicyGenerator struct {
+	// Identity is the identity for which a policy is generated.
+	// Audit events of other identities are ignored.
+	Identity Identity
+
+	// Since and Until limit the time window of audit events.
+	// Events before Since or not before Until are ignored.
+	// If zero, the time window is not bounded.
+	Since, Until time.Time
+
+	// Threshold controls when API arguments, like key names,
+	// are collapsed into a single pattern. Arguments sharing
+	// the same stem, the argument up to its last '-', '_', '.'
+	// or ':' character, are replaced by the stem followed by
+	// an asterisk ('*') if the identity has used at least
+	// Threshold distinct arguments with this stem.
+	//
+	// For example, with a Threshold of 2, "/v1/key/decrypt/app-1"
+	// and "/v1/key/decrypt/app-2" are collapsed into the pattern
+	// "/v1/key/decrypt/app-*".
+	//
+	// If Threshold is 0, arguments are never collapsed.
+	Threshold int
+}
+
+// Generate reads the audit events from the stream and returns a
+// policy that allows all requests of the generator's identity
+// within the time window. Requests that have been rejected with
+// a 403 Forbidden status code are ignored. Generate reads the
+// stream until the end and closes it.
+func (g *PolicyGenerator) Generate(stream *AuditStream) (*Policy, error) {
+	apis := map[string]map[string]struct{}{} // API path -> set of arguments
+	for stream.Next() {
+		event := stream.Event()
+		if event.ClientIdentity != g.Identity || event.StatusCode == http.StatusForbidden {
+			continue
+		}
+		if !g.Since.IsZero() && event.Timestamp.Before(g.Since) {
+			continue
+		}
+		if !g.Until.IsZero() && !event.Timestamp.Before(g.Until) {
+			continue
+		}
+
+		u, err := url.Parse(event.APIPath)
+		if err != nil {
+			stream.Close()
+			return nil, err
+		}
+		api := apiPath(u.Path)
+		if _, ok := apis[api]; !ok {
+			apis[api] = map[string]struct{}{}
+		}
+		arg := strings.TrimPrefix(strings.TrimPrefix(u.Path, api), "/")
+		apis[api][arg] = struct{}{}
+	}
+
+	// Once closed, Close returns the first error encountered
+	// while reading the stream, if any.
+	stream.Close()
+	if err := stream.Close(); err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{
+		Allow: make(map[string]Rule, len(apis)),
+		Deny:  map[string]Rule{},
+	}
+	for api, args := range apis {
+		if _, ok := args[""]; ok { // Requests without argument
+			policy.Allow[api] = Rule{}
+			delete(args, "")
+		}
+		for _, pattern := range collapseArgs(args, g.Threshold) {
+			policy.Allow[api+"/"+pattern] = Rule{}
+		}
+	}
+	return policy, nil
+}
+
+// PolicyDiff describes the changes required to turn
+// one policy into another.
+type PolicyDiff struct {
+	AddAllow    map[string]Rule // Allow rules to add
+	RemoveAllow map[string]Rule // Allow rules to remove
+	AddDeny     map[string]Rule // Deny rules to add
+	RemoveDeny  map[string]Rule // Deny rules to remove
+}
+
+// IsEmpty reports whether the PolicyDiff contains no changes.
+func (d *PolicyDiff) IsEmpty() bool {
+	return len(d.AddAllow) == 0 && len(d.RemoveAllow) == 0 && len(d.AddDeny) == 0 && len(d.RemoveDeny) == 0
+}
+
+// Diff returns the changes required to turn the Policy p
+// into o. For example, to compare an identity's current
+// policy with a generated one.
+func (p *Policy) Diff(o *Policy) PolicyDiff {
+	return PolicyDiff{
+		AddAllow:    diffRules(o.Allow, p.Allow),
+		RemoveAllow: diffRules(p.Allow, o.Allow),
+		AddDeny:     diffRules(o.Deny, p.Deny),
+		RemoveDeny:  diffRules(p.Deny, o.Deny),
+	}
+}
+
+// diffRules returns all rules of a that are not in b.
+func diffRules(a, b map[string]Rule) map[string]Rule {
+	diff := map[string]Rule{}
+	for pattern, rule := range a {
+		if _, ok := b[pattern]; !ok {
+			diff[pattern] = rule
+		}
+	}
+	return diff
+}
+
+// collapseArgs returns a sorted list of patterns matching all
+// args. Args with the same stem are collapsed into a single
+// pattern if there are at least threshold of them.
+//
+// A pattern with a trailing '*' matches any API path that
+// starts with the pattern, without the '*'. Refer to
+// Policy.Verify.
+func collapseArgs(args map[string]struct{}, threshold int) []string {
+	stems := map[string][]string{}
+	for arg := range args {
+		var stem string
+		if threshold > 0 {
+			if i := strings.LastIndexAny(arg, "-_.:"); i >= 0 {
+				stem = arg[:i+1]
+			}
+		}
+		stems[stem] = append(stems[stem], arg)
+	}
+
+	patterns := make([]string, 0, len(args))
+	for stem, group := range stems {
+		if stem != "" && len(group) >= threshold {
+			patterns = append(patterns, stem+"*")
+		} else {
+			patterns = append(patterns, group...)
+		}
+	}
+	slices.Sort(patterns)
+	return patterns
+}
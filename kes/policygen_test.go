@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kes
+
+import (
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPolicyGenerator(t *testing.T) {
+	const (
+		Alice Identity = "3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22"
+		Bob   Identity = "ba2cc7d4cd1a8b9b8fa0b4e3b8eec7e4b66e3b7a8ee41b3bb5c7cf3a0b4ac1b6"
+	)
+	event := func(time, path string, identity Identity, code int) string {
+		return `{"time":"` + time + `","request":{"path":"` + path + `","identity":"` + string(identity) + `"},"response":{"code":` + strconv.Itoa(code) + `}}`
+	}
+	events := strings.Join([]string{
+		event("2025-01-01T00:00:00Z", "/v1/status", Alice, 200),
+		event("2025-01-01T00:00:01Z", "/v1/key/decrypt/app-1", Alice, 200),
+		event("2025-01-01T00:00:02Z", "/v1/key/decrypt/app-2", Alice, 200),
+		event("2025-01-01T00:00:03Z", "/v1/key/decrypt/app-3", Alice, 200),
+		event("2025-01-01T00:00:04Z", "/v1/key/generate/app-1", Alice, 200),
+		event("2025-01-01T00:00:05Z", "/v1/key/generate/db", Alice, 404),
+		event("2025-01-01T00:00:06Z", "/v1/key/create/app-4", Alice, 403),
+		event("2025-01-01T00:00:07Z", "/v1/key/delete/app-1", Bob, 200),
+		event("2025-01-01T00:00:08Z", "/v1/key/list", Alice, 200),
+		event("2025-01-01T00:00:09Z", "/v1/key/list/db", Alice, 200),
+		event("2025-01-02T00:00:00Z", "/v1/key/delete/app-1", Alice, 200),
+	}, "\n")
+
+	for i, test := range policyGeneratorTests {
+		test.Generator.Identity = Alice
+		policy, err := test.Generator.Generate(NewAuditStream(strings.NewReader(events)))
+		if err != nil {
+			t.Fatalf("Test %d: failed to generate policy: %v", i, err)
+		}
+		if allow := slices.Sorted(maps.Keys(policy.Allow)); !slices.Equal(allow, test.Allow) {
+			t.Fatalf("Test %d: allow rules mismatch: got '%v' - want '%v'", i, allow, test.Allow)
+		}
+	}
+}
+
+var policyGeneratorTests = []struct {
+	Generator PolicyGenerator
+	Allow     []string
+}{
+	{ // 0
+		Generator: PolicyGenerator{},
+		Allow: []string{
+			"/v1/key/decrypt/app-1",
+			"/v1/key/decrypt/app-2",
+			"/v1/key/decrypt/app-3",
+			"/v1/key/delete/app-1",
+			"/v1/key/generate/app-1",
+			"/v1/key/generate/db",
+			"/v1/key/list",
+			"/v1/key/list/db",
+			"/v1/status",
+		},
+	},
+	{ // 1
+		Generator: PolicyGenerator{Threshold: 3},
+		Allow: []string{
+			"/v1/key/decrypt/app-*",
+			"/v1/key/delete/app-1",
+			"/v1/key/generate/app-1",
+			"/v1/key/generate/db",
+			"/v1/key/list",
+			"/v1/key/list/db",
+			"/v1/status",
+		},
+	},
+	{ // 2
+		Generator: PolicyGenerator{
+			Threshold: 1,
+			Since:     time.Date(2025, 1, 1, 0, 0, 1, 0, time.UTC),
+			Until:     time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+		Allow: []string{
+			"/v1/key/decrypt/app-*",
+			"/v1/key/generate/app-*",
+			"/v1/key/generate/db",
+			"/v1/key/list",
+			"/v1/key/list/db",
+		},
+	},
+}
+
+func TestPolicyGenerator_InvalidStream(t *testing.T) {
+	const Alice Identity = "3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22"
+
+	// The stream has a closer. Generate must report the decoding
+	// error even though closing the stream succeeds.
+	stream := NewAuditStream(io.NopCloser(strings.NewReader(`{"time":"2025-01-01T00:00:00Z"}` + "\n{")))
+	if _, err := (&PolicyGenerator{Identity: Alice}).Generate(stream); err == nil {
+		t.Fatal("Generated policy from invalid audit stream")
+	}
+}
+
+func TestPolicyDiff(t *testing.T) {
+	current := &Policy{
+		Allow: map[string]Rule{"/v1/key/*": {}, "/v1/status": {}},
+		Deny:  map[string]Rule{"/v1/key/delete/*": {}},
+	}
+	proposed := &Policy{
+		Allow: map[string]Rule{"/v1/key/decrypt/app-*": {}, "/v1/status": {}},
+	}
+
+	diff := current.Diff(proposed)
+	if add := slices.Sorted(maps.Keys(diff.AddAllow)); !slices.Equal(add, []string{"/v1/key/decrypt/app-*"}) {
+		t.Fatalf("Allow rules to add mismatch: got '%v'", add)
+	}
+	if remove := slices.Sorted(maps.Keys(diff.RemoveAllow)); !slices.Equal(remove, []string{"/v1/key/*"}) {
+		t.Fatalf("Allow rules to remove mismatch: got '%v'", remove)
+	}
+	if len(diff.AddDeny) != 0 {
+		t.Fatalf("Deny rules to add mismatch: got '%v'", diff.AddDeny)
+	}
+	if remove := slices.Sorted(maps.Keys(diff.RemoveDeny)); !slices.Equal(remove, []string{"/v1/key/delete/*"}) {
+		t.Fatalf("Deny rules to remove mismatch: got '%v'", remove)
+	}
+	if diff = current.Diff(current); !diff.IsEmpty() {
+		t.Fatalf("Diff of equal policies is not empty: %v", diff)
+	}
+}
@@ -0,0 +1,414 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package policytest implements offline regression tests for
+// KES policies.
+//
+// A policy test consists of a set of policies and a file with
+// declarative assertions about them. Each line of an assertion
+// file is either empty, a comment starting with '#', or one of:
+//
+//	identity <identity> uses <policy>
+//	identity <identity> may <METHOD> <path>
+//	identity <identity> may not <METHOD> <path>
+//	policy <policy> may <METHOD> <path>
+//	policy <policy> may not <METHOD> <path>
+//
+// The first form assigns a policy to an identity. The others
+// assert that an identity, resp. a policy, allows or rejects
+// requests to the given API path. A path ending with an
+// asterisk ('*') refers to all paths with this prefix. KES
+// policies only match the API path of a request. Hence, the
+// METHOD must be the HTTP method of the API the path refers
+// to. For example:
+//
+//	identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 uses my-app
+//	identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 may POST /v1/key/encrypt/app-*
+//	identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 may not DELETE /v1/key/delete/*
+//
+// Policies are defined as JSON object that maps policy names
+// to allow and deny rules:
+//
+//	{
+//	  "my-app": {
+//	    "allow": ["/v1/key/encrypt/app-*", "/v1/key/decrypt/app-*"],
+//	    "deny":  ["/v1/key/delete/*"]
+//	  }
+//	}
+//
+// Policy tests are usually run as part of "go test":
+//
+//	func TestPolicies(t *testing.T) {
+//		test, err := policytest.Load(os.DirFS("."), "policies.json", "policies.test")
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		test.Check(t)
+//	}
+package policytest
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/openstor/kms-go/kes"
+)
+
+// Test is a set of policies and assertions about them.
+type Test struct {
+	// Policies contains the policies by name.
+	Policies map[string]*kes.Policy
+
+	// Identities maps identities to the names of
+	// their assigned policies.
+	Identities map[kes.Identity]string
+
+	// Assertions is the list of assertions evaluated
+	// by the Test.
+	Assertions []Assertion
+}
+
+// Load reads the policies from the policy file and the
+// assertions from the assertion file within fsys.
+func Load(fsys fs.FS, policyFile, assertionFile string) (*Test, error) {
+	f, err := fsys.Open(policyFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	policies, err := ReadPolicies(f)
+	if err != nil {
+		return nil, fmt.Errorf("policytest: %s: %v", policyFile, err)
+	}
+
+	a, err := fsys.Open(assertionFile)
+	if err != nil {
+		return nil, err
+	}
+	defer a.Close()
+
+	test, err := ReadAssertions(a)
+	if err != nil {
+		return nil, fmt.Errorf("policytest: %s: %v", assertionFile, err)
+	}
+	test.Policies = policies
+	return test, nil
+}
+
+// ReadPolicies reads a JSON object mapping policy names to
+// allow and deny rules from r. The rules may either be a
+// JSON array of API path patterns or a JSON object with the
+// patterns as keys.
+func ReadPolicies(r io.Reader) (map[string]*kes.Policy, error) {
+	var policies map[string]struct {
+		Allow rules `json:"allow"`
+		Deny  rules `json:"deny"`
+	}
+	if err := json.NewDecoder(r).Decode(&policies); err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*kes.Policy, len(policies))
+	for name, p := range policies {
+		m[name] = &kes.Policy{
+			Allow: p.Allow,
+			Deny:  p.Deny,
+		}
+	}
+	return m, nil
+}
+
+// ReadAssertions parses the assertion file from r and returns
+// a Test containing its assertions and policy assignments.
+func ReadAssertions(r io.Reader) (*Test, error) {
+	test := &Test{
+		Identities: map[kes.Identity]string{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 3 || (fields[0] != "identity" && fields[0] != "policy") {
+			return nil, fmt.Errorf("line %d: invalid assertion '%s'", n, line)
+		}
+		if fields[0] == "identity" && fields[2] == "uses" {
+			if len(fields) != 4 {
+				return nil, fmt.Errorf("line %d: invalid policy assignment '%s'", n, line)
+			}
+			test.Identities[kes.Identity(fields[1])] = fields[3]
+			continue
+		}
+
+		a := Assertion{Line: n, Allow: true}
+		if fields[0] == "identity" {
+			a.Identity = kes.Identity(fields[1])
+		} else {
+			a.Policy = fields[1]
+		}
+		rest := fields[2:]
+		if rest[0] != "may" {
+			return nil, fmt.Errorf("line %d: invalid assertion '%s': expected 'may' or 'may not'", n, line)
+		}
+		if rest = rest[1:]; len(rest) > 0 && rest[0] == "not" {
+			a.Allow, rest = false, rest[1:]
+		}
+		if len(rest) != 2 {
+			return nil, fmt.Errorf("line %d: invalid assertion '%s': expected '<METHOD> <path>'", n, line)
+		}
+		a.Method, a.Path = strings.ToUpper(rest[0]), rest[1]
+		if !strings.HasPrefix(a.Path, "/") || strings.Count(a.Path, "*") > 1 || (strings.Contains(a.Path, "*") && !strings.HasSuffix(a.Path, "*")) {
+			return nil, fmt.Errorf("line %d: invalid API path '%s'", n, a.Path)
+		}
+		if err := checkMethod(a.Method, a.Path); err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+		test.Assertions = append(test.Assertions, a)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return test, nil
+}
+
+// Assertion is a statement about whether an identity or
+// a policy allows requests to an API path.
+type Assertion struct {
+	Line int // The line number within the assertion file
+
+	// Either Identity or Policy is set. If Identity is
+	// set, the assertion refers to the policy assigned
+	// to the identity.
+	Identity kes.Identity
+	Policy   string
+
+	Allow  bool   // Whether the requests should be allowed
+	Method string // The HTTP method. For example, "POST"
+	Path   string // The API path. May end with an asterisk ('*')
+}
+
+// String returns the Assertion's textual representation.
+func (a *Assertion) String() string {
+	subject := "policy " + a.Policy
+	if a.Identity != "" {
+		subject = "identity " + a.Identity.String()
+	}
+	verb := "may"
+	if !a.Allow {
+		verb = "may not"
+	}
+	return fmt.Sprintf("%s %s %s %s", subject, verb, a.Method, a.Path)
+}
+
+// Failure describes an assertion that does not hold.
+type Failure struct {
+	Assertion Assertion
+	Reason    string
+}
+
+// Error returns the line, assertion and reason of the failure.
+func (f *Failure) Error() string {
+	return fmt.Sprintf("line %d: %s: %s", f.Assertion.Line, f.Assertion.String(), f.Reason)
+}
+
+// Evaluate evaluates all assertions and returns the
+// ones that don't hold. It returns no failures if all
+// assertions hold.
+func (t *Test) Evaluate() []Failure {
+	var failures []Failure
+	for _, a := range t.Assertions {
+		name := a.Policy
+		if a.Identity != "" {
+			var ok bool
+			if name, ok = t.Identities[a.Identity]; !ok {
+				failures = append(failures, Failure{Assertion: a, Reason: "identity has no policy assigned"})
+				continue
+			}
+		}
+		policy, ok := t.Policies[name]
+		if !ok {
+			failures = append(failures, Failure{Assertion: a, Reason: fmt.Sprintf("policy '%s' does not exist", name)})
+			continue
+		}
+
+		if reason := evaluate(policy, &a); reason != "" {
+			failures = append(failures, Failure{
+				Assertion: a,
+				Reason:    fmt.Sprintf("%s by policy '%s'", reason, name),
+			})
+		}
+	}
+	return failures
+}
+
+// Check evaluates all assertions and reports each failure
+// as test error to tb.
+func (t *Test) Check(tb testing.TB) {
+	tb.Helper()
+
+	for _, f := range t.Evaluate() {
+		tb.Error(f.Error())
+	}
+}
+
+// evaluate returns a non-empty reason if the assertion a does
+// not hold for the policy.
+//
+// For assertions about a single API path, it uses Policy.Verify.
+// Assertions about all paths with a prefix hold if all, resp. none,
+// of these paths are allowed.
+func evaluate(policy *kes.Policy, a *Assertion) string {
+	if !strings.HasSuffix(a.Path, "*") {
+		allowed := verify(policy, a.Path)
+		if a.Allow && !allowed {
+			return "rejected"
+		}
+		if !a.Allow && allowed {
+			return "allowed"
+		}
+		return ""
+	}
+
+	if a.Allow {
+		// All paths are allowed if one allow pattern covers all
+		// of them and no deny pattern overlaps with any of them.
+		var covered bool
+		for pattern := range policy.Allow {
+			if covered = match(pattern, a.Path); covered {
+				break
+			}
+		}
+		if !covered {
+			return "not entirely allowed"
+		}
+		for pattern := range policy.Deny {
+			if match(pattern, a.Path) || match(a.Path, pattern) {
+				return fmt.Sprintf("partially rejected by '%s'", pattern)
+			}
+		}
+		return ""
+	}
+
+	// No path is allowed if, for every allow pattern overlapping
+	// with the paths, a deny pattern covers the overlap.
+	for allow := range policy.Allow {
+		var overlap string
+		switch {
+		case match(allow, a.Path):
+			overlap = a.Path
+		case match(a.Path, allow):
+			overlap = allow
+		default:
+			continue
+		}
+
+		var denied bool
+		for deny := range policy.Deny {
+			if denied = match(deny, overlap); denied {
+				break
+			}
+		}
+		if !denied {
+			return fmt.Sprintf("partially allowed by '%s'", allow)
+		}
+	}
+	return ""
+}
+
+// match reports whether the pattern matches s as Policy.Verify
+// does. If s ends with an asterisk ('*'), it is matched as is.
+// Hence, match reports whether the pattern matches all paths
+// with the prefix s.
+func match(pattern, s string) bool {
+	return verify(&kes.Policy{Allow: map[string]kes.Rule{pattern: {}}}, s)
+}
+
+// verify reports whether the policy allows requests to path.
+func verify(policy *kes.Policy, path string) bool {
+	return policy.Verify(&http.Request{URL: &url.URL{Path: path}}) == nil
+}
+
+// apiMethods maps the KES server APIs to their HTTP method.
+var apiMethods = map[string]string{
+	"/version":                   http.MethodGet,
+	"/v1/ready":                  http.MethodGet,
+	"/v1/status":                 http.MethodGet,
+	"/v1/api":                    http.MethodGet,
+	"/v1/metrics":                http.MethodGet,
+	"/v1/key/create":             http.MethodPost,
+	"/v1/key/import":             http.MethodPost,
+	"/v1/key/describe":           http.MethodGet,
+	"/v1/key/delete":             http.MethodDelete,
+	"/v1/key/generate":           http.MethodPost,
+	"/v1/key/encrypt":            http.MethodPost,
+	"/v1/key/decrypt":            http.MethodPost,
+	"/v1/key/hmac":               http.MethodPut,
+	"/v1/key/list":               http.MethodGet,
+	"/v1/policy/describe":        http.MethodGet,
+	"/v1/policy/read":            http.MethodGet,
+	"/v1/policy/list":            http.MethodGet,
+	"/v1/identity/describe":      http.MethodGet,
+	"/v1/identity/self/describe": http.MethodGet,
+	"/v1/identity/list":          http.MethodGet,
+	"/v1/log/audit":              http.MethodGet,
+	"/v1/log/error":              http.MethodGet,
+}
+
+// checkMethod returns an error if path does not refer to any
+// KES API or if method is not the HTTP method of all APIs the
+// path refers to.
+func checkMethod(method, path string) error {
+	prefix, wildcard := strings.CutSuffix(path, "*")
+
+	var found bool
+	for api, m := range apiMethods {
+		refers := path == api || strings.HasPrefix(path, api+"/") || (wildcard && strings.HasPrefix(api, prefix))
+		if !refers {
+			continue
+		}
+		if m != method {
+			return fmt.Errorf("invalid method '%s' for API path '%s': API '%s' requires '%s'", method, path, api, m)
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("unknown API path '%s'", path)
+	}
+	return nil
+}
+
+// rules is a set of API path patterns. It can be decoded
+// from a JSON array or a JSON object.
+type rules map[string]kes.Rule
+
+func (r *rules) UnmarshalJSON(b []byte) error {
+	var patterns []string
+	if err := json.Unmarshal(b, &patterns); err == nil {
+		*r = make(rules, len(patterns))
+		for _, pattern := range patterns {
+			(*r)[pattern] = kes.Rule{}
+		}
+		return nil
+	}
+
+	var m map[string]kes.Rule
+	if err := json.Unmarshal(b, &m); err != nil {
+		return errors.New("invalid rules: must be JSON array or object")
+	}
+	*r = m
+	return nil
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package policytest
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const testPolicies = `{
+  "my-app": {
+    "allow": ["/v1/key/encrypt/app-*", "/v1/key/decrypt/app-*", "/v1/key/delete/*"],
+    "deny":  ["/v1/key/delete/app-*"]
+  },
+  "admin": {
+    "allow": {"/v1/*": {}}
+  }
+}`
+
+func TestEvaluate(t *testing.T) {
+	fsys := fstest.MapFS{
+		"policies.json": {Data: []byte(testPolicies)},
+	}
+	for i, test := range evaluateTests {
+		fsys["policies.test"] = &fstest.MapFile{Data: []byte(test.Assertions)}
+
+		policyTest, err := Load(fsys, "policies.json", "policies.test")
+		if err != nil {
+			t.Fatalf("Test %d: failed to load policy test: %v", i, err)
+		}
+		failures := policyTest.Evaluate()
+		if len(failures) != len(test.Failures) {
+			t.Fatalf("Test %d: got %d failures - want %d: %v", i, len(failures), len(test.Failures), failures)
+		}
+		for j, f := range failures {
+			if f.Assertion.Line != test.Failures[j] {
+				t.Fatalf("Test %d: failure %d: got line %d - want %d: %v", i, j, f.Assertion.Line, test.Failures[j], f.Error())
+			}
+		}
+	}
+}
+
+var evaluateTests = []struct {
+	Assertions string
+	Failures   []int // Line numbers of failing assertions
+}{
+	{ // 0
+		Assertions: strings.Join([]string{
+			"# my-app may only use app keys",
+			"identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 uses my-app",
+			"identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 may POST /v1/key/encrypt/app-*",
+			"identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 may POST /v1/key/decrypt/app-1",
+			"identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 may not POST /v1/key/encrypt/db-*",
+			"identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 may not DELETE /v1/key/delete/app-*",
+			"",
+			"policy admin may DELETE /v1/key/delete/*",
+		}, "\n"),
+	},
+	{ // 1
+		Assertions: strings.Join([]string{
+			"identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 uses my-app",
+			"identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 may POST /v1/key/encrypt/*",
+			"identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 may not DELETE /v1/key/delete/*",
+			"identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 may DELETE /v1/key/delete/*",
+			"identity 3ecfcdf38fcbe141ae26a1030f81e96b753365a46760ae6b578698a97c59fd22 may not POST /v1/key/decrypt/app-1",
+			"identity ba2cc7d4cd1a8b9b8fa0b4e3b8eec7e4b66e3b7a8ee41b3bb5c7cf3a0b4ac1b6 may GET /v1/status",
+			"policy unknown may GET /v1/status",
+		}, "\n"),
+		Failures: []int{2, 3, 4, 5, 6, 7},
+	},
+}
+
+func TestReadAssertions(t *testing.T) {
+	for i, test := range readAssertionsTests {
+		_, err := ReadAssertions(strings.NewReader(test.Assertion))
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: should have failed to parse '%s'", i, test.Assertion)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to parse '%s': %v", i, test.Assertion, err)
+		}
+	}
+}
+
+var readAssertionsTests = []struct {
+	Assertion  string
+	ShouldFail bool
+}{
+	{Assertion: "identity X uses my-app"},
+	{Assertion: "identity X may POST /v1/key/encrypt/app-*"},
+	{Assertion: "policy my-app may not GET /v1/status"},
+	{Assertion: "identity X uses", ShouldFail: true},
+	{Assertion: "identity X can POST /v1/key/encrypt/app-*", ShouldFail: true},
+	{Assertion: "identity X may /v1/key/encrypt/app-*", ShouldFail: true},
+	{Assertion: "identity X may POST v1/key/encrypt/app-*", ShouldFail: true},
+	{Assertion: "identity X may POST /v1/key/*/app-*", ShouldFail: true},
+	{Assertion: "key X may POST /v1/key/encrypt/app-*", ShouldFail: true},
+	{Assertion: "identity X may not DELETE /v1/key/delete/*"},
+	{Assertion: "identity X may not DELETE /v1/key/create/app-1", ShouldFail: true},
+	{Assertion: "identity X may GET /v1/key/*", ShouldFail: true},
+	{Assertion: "identity X may GET /v1/unknown", ShouldFail: true},
+}
@@ -0,0 +1,458 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"aead.dev/mem"
+	pb "github.com/openstor/kms-go/kms/protobuf"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// DBChange is a single state change of a KMS server database.
+// An incremental database snapshot is a stream of DBChanges.
+type DBChange struct {
+	// Commit is the commit index of the state change.
+	Commit uint64
+
+	// Time is the point in time when the state change has
+	// been committed.
+	Time time.Time
+
+	// Data is the encoded state change. It is opaque to
+	// clients.
+	Data []byte
+}
+
+// MarshalPB converts the DBChange into its protobuf representation.
+func (c *DBChange) MarshalPB(v *pb.DBChange) error {
+	v.Commit = c.Commit
+	v.Time = pb.Time(c.Time)
+	v.Data = c.Data
+	return nil
+}
+
+// UnmarshalPB initializes the DBChange from its protobuf representation.
+func (c *DBChange) UnmarshalPB(v *pb.DBChange) error {
+	c.Commit = v.Commit
+	c.Time = v.Time.AsTime()
+	c.Data = v.Data
+	return nil
+}
+
+// NewDBChangeReader returns a new DBChangeReader that reads
+// DBChanges from an incremental database snapshot r.
+func NewDBChangeReader(r io.Reader) *DBChangeReader {
+	return &DBChangeReader{
+		r:   r,
+		buf: make([]byte, 4),
+	}
+}
+
+// DBChangeReader reads DBChanges from an incremental database
+// snapshot. Each DBChange is encoded as 4 byte big endian length
+// followed by its protobuf representation.
+type DBChangeReader struct {
+	r   io.Reader
+	buf []byte
+	err error
+}
+
+// Next returns the next DBChange, if any, and a boolean
+// flag indicating whether there was an actual DBChange.
+//
+// Once Next returns false, there are no more DBChanges.
+// Callers should use Err to check for any error encountered
+// while reading from the underlying stream.
+func (r *DBChangeReader) Next() (DBChange, bool) {
+	if r.err != nil {
+		return DBChange{}, false
+	}
+
+	var change DBChange
+	if r.err = r.readChange(&change); r.err != nil {
+		return DBChange{}, false
+	}
+	return change, true
+}
+
+// Err returns the first error encountered while reading,
+// if any. It returns nil at the end of the stream.
+func (r *DBChangeReader) Err() error {
+	if errors.Is(r.err, io.EOF) {
+		return nil
+	}
+	return r.err
+}
+
+func (r *DBChangeReader) readChange(change *DBChange) error {
+	const MaxSize = 64 * mem.MiB
+
+	if _, err := io.ReadFull(r.r, r.buf[:4]); err != nil {
+		return err
+	}
+
+	msgLen := binary.BigEndian.Uint32(r.buf)
+	if uint64(msgLen) > uint64(MaxSize) {
+		return errors.New("kms: database change too large")
+	}
+	if uint64(cap(r.buf)) < uint64(msgLen) {
+		r.buf = make([]byte, msgLen)
+	}
+
+	if _, err := io.ReadFull(r.r, r.buf[:msgLen]); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return pb.Unmarshal(r.buf[:msgLen], change)
+}
+
+// writeDBChange writes the length-encoded protobuf representation
+// of change to w.
+func writeDBChange(w io.Writer, change *DBChange) error {
+	b, err := pb.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(b)))
+	if _, err = w.Write(size[:]); err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// BackupSegment describes a single database snapshot within
+// a backup chain.
+type BackupSegment struct {
+	// Name identifies the database snapshot. For example,
+	// a file or object name.
+	Name string
+
+	// Since is the commit index after which the segment
+	// contains all state changes. It is 0 for full snapshots.
+	Since uint64
+
+	// Commit is the commit index of the most recent state
+	// change contained in the segment.
+	Commit uint64
+
+	// CreatedAt is the point in time when the segment has
+	// been created.
+	CreatedAt time.Time
+}
+
+// MarshalPB converts the BackupSegment into its protobuf representation.
+func (s *BackupSegment) MarshalPB(v *pb.BackupSegment) error {
+	v.Name = s.Name
+	v.Since = s.Since
+	v.Commit = s.Commit
+	v.CreatedAt = pb.Time(s.CreatedAt)
+	return nil
+}
+
+// UnmarshalPB initializes the BackupSegment from its protobuf representation.
+func (s *BackupSegment) UnmarshalPB(v *pb.BackupSegment) error {
+	s.Name = v.Name
+	s.Since = v.Since
+	s.Commit = v.Commit
+	s.CreatedAt = v.CreatedAt.AsTime()
+	return nil
+}
+
+// BackupManifest describes a chain of database snapshots. The
+// first segment is a full snapshot and each subsequent segment
+// is an incremental snapshot that continues where the previous
+// segment ended.
+//
+// A typical backup schedule takes a full snapshot from time to
+// time and incremental snapshots in between:
+//
+//	resp, err := client.ReadDB(ctx, &kms.ReadDBRequest{
+//		Since: manifest.Commit(),
+//	})
+//	// Store resp.Body, e.g. as file, and add it to the manifest.
+//	err = manifest.Add(kms.BackupSegment{
+//		Name:      name,
+//		Since:     resp.Since,
+//		Commit:    resp.Commit,
+//		CreatedAt: time.Now(),
+//	})
+type BackupManifest struct {
+	Segments []BackupSegment
+}
+
+// Commit returns the commit index of the most recent state
+// change contained in the backup chain. It returns 0 if the
+// manifest is empty.
+func (m *BackupManifest) Commit() uint64 {
+	if len(m.Segments) == 0 {
+		return 0
+	}
+	return m.Segments[len(m.Segments)-1].Commit
+}
+
+// Add appends the segment to the backup chain. It returns an
+// error if the segment does not continue the chain. A full
+// snapshot starts a new chain and replaces all existing
+// segments.
+func (m *BackupManifest) Add(seg BackupSegment) error {
+	if seg.Since == 0 {
+		m.Segments = append(m.Segments[:0], seg)
+		return nil
+	}
+	if len(m.Segments) == 0 {
+		return errors.New("kms: backup chain must start with a full snapshot")
+	}
+	if commit := m.Commit(); seg.Since != commit {
+		return fmt.Errorf("kms: backup segment '%s' starts after commit %d but chain ends at commit %d", seg.Name, seg.Since, commit)
+	}
+	if seg.Commit < seg.Since {
+		return fmt.Errorf("kms: backup segment '%s' ends before it starts", seg.Name)
+	}
+	m.Segments = append(m.Segments, seg)
+	return nil
+}
+
+// Verify checks whether the manifest describes a valid backup
+// chain. The first segment must be a full snapshot and each
+// subsequent segment must continue where the previous segment
+// ended.
+func (m *BackupManifest) Verify() error {
+	if len(m.Segments) == 0 {
+		return errors.New("kms: backup chain is empty")
+	}
+	if m.Segments[0].Since != 0 {
+		return errors.New("kms: backup chain must start with a full snapshot")
+	}
+	for i := 1; i < len(m.Segments); i++ {
+		prev, seg := m.Segments[i-1], m.Segments[i]
+		if seg.Since == 0 {
+			return fmt.Errorf("kms: backup segment '%s' is a full snapshot within the chain", seg.Name)
+		}
+		if seg.Since != prev.Commit {
+			return fmt.Errorf("kms: backup segment '%s' starts after commit %d but previous segment ends at commit %d", seg.Name, seg.Since, prev.Commit)
+		}
+		if seg.Commit < seg.Since {
+			return fmt.Errorf("kms: backup segment '%s' ends before it starts", seg.Name)
+		}
+	}
+	return nil
+}
+
+// MarshalPB converts the BackupManifest into its protobuf representation.
+func (m *BackupManifest) MarshalPB(v *pb.BackupManifest) error {
+	v.Segments = make([]*pb.BackupSegment, 0, len(m.Segments))
+	for i := range m.Segments {
+		seg := new(pb.BackupSegment)
+		if err := m.Segments[i].MarshalPB(seg); err != nil {
+			return err
+		}
+		v.Segments = append(v.Segments, seg)
+	}
+	return nil
+}
+
+// UnmarshalPB initializes the BackupManifest from its protobuf representation.
+func (m *BackupManifest) UnmarshalPB(v *pb.BackupManifest) error {
+	m.Segments = make([]BackupSegment, 0, len(v.Segments))
+	for _, s := range v.Segments {
+		var seg BackupSegment
+		if err := seg.UnmarshalPB(s); err != nil {
+			return err
+		}
+		m.Segments = append(m.Segments, seg)
+	}
+	return nil
+}
+
+// MarshalJSON returns the BackupManifest's JSON representation.
+func (m *BackupManifest) MarshalJSON() ([]byte, error) {
+	var v pb.BackupManifest
+	if err := m.MarshalPB(&v); err != nil {
+		return nil, err
+	}
+	return protojson.Marshal(&v)
+}
+
+// UnmarshalJSON initializes the BackupManifest from its JSON
+// representation.
+func (m *BackupManifest) UnmarshalJSON(b []byte) error {
+	var v pb.BackupManifest
+	if err := protojson.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	return m.UnmarshalPB(&v)
+}
+
+// RestoreDBRequest contains options for restoring a KMS server's
+// database from a chain of database snapshots.
+type RestoreDBRequest struct {
+	// Host to which the database snapshots are sent.
+	Host string
+
+	// Manifest describes the chain of database snapshots.
+	Manifest *BackupManifest
+
+	// Open returns the content of the database snapshot
+	// described by the given segment.
+	Open func(context.Context, BackupSegment) (io.ReadCloser, error)
+
+	// Commit is an optional commit index. If > 0, only state
+	// changes up to and including this commit index are restored.
+	Commit uint64
+
+	// Time is an optional point in time. If not zero, only state
+	// changes committed at or before this point in time are restored.
+	Time time.Time
+}
+
+// RestoreDB restores the database of req.Host from a chain of
+// database snapshots. It writes the full snapshot followed by
+// all incremental snapshots using WriteDB. If req.Commit or
+// req.Time is set, RestoreDB replays state changes only up to
+// the given commit index resp. point in time.
+//
+// A point-in-time restore requires that the full snapshot has
+// been taken before the target. RestoreDB returns an error if
+// the target lies before the end of the full snapshot.
+//
+// RestoreDB also returns an error if an incremental snapshot is
+// truncated or contains state changes that don't match its
+// manifest segment. Since snapshots are streamed, the server
+// may have received all preceding segments at this point.
+//
+// It requires SysAdmin privileges.
+//
+// The returned error is of type *HostError.
+func (c *Client) RestoreDB(ctx context.Context, req *RestoreDBRequest) error {
+	if req.Host == "" {
+		return hostError("", errors.New("kms: no host specified"))
+	}
+	if req.Manifest == nil {
+		return hostError(req.Host, errors.New("kms: no backup manifest specified"))
+	}
+	if err := req.Manifest.Verify(); err != nil {
+		return hostError(req.Host, err)
+	}
+
+	full := req.Manifest.Segments[0]
+	if req.Commit > 0 && req.Commit < full.Commit {
+		return hostError(req.Host, fmt.Errorf("kms: full snapshot '%s' ends after target commit %d", full.Name, req.Commit))
+	}
+	if !req.Time.IsZero() && req.Time.Before(full.CreatedAt) {
+		return hostError(req.Host, fmt.Errorf("kms: full snapshot '%s' has been created after target time %v", full.Name, req.Time))
+	}
+
+	body, err := req.Open(ctx, full)
+	if err != nil {
+		return hostError(req.Host, err)
+	}
+	err = c.WriteDB(ctx, &WriteDBRequest{
+		Host: req.Host,
+		Body: body,
+	})
+	if closeErr := body.Close(); err == nil && closeErr != nil {
+		err = hostError(req.Host, closeErr)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, seg := range req.Manifest.Segments[1:] {
+		if req.Commit > 0 && seg.Since >= req.Commit {
+			break
+		}
+
+		body, err := req.Open(ctx, seg)
+		if err != nil {
+			return hostError(req.Host, err)
+		}
+		done, err := c.restoreSegment(ctx, req, seg, body)
+		if closeErr := body.Close(); err == nil && closeErr != nil {
+			err = hostError(req.Host, closeErr)
+		}
+		if err != nil {
+			return err
+		}
+		if done {
+			break
+		}
+	}
+	return nil
+}
+
+// restoreSegment writes all state changes of the incremental
+// snapshot up to the req.Commit and req.Time to req.Host. It
+// reports whether the restore target has been reached.
+//
+// It returns an error if the snapshot contains state changes
+// outside the commit range described by seg or ends before
+// seg.Commit. Such a snapshot is either truncated or does not
+// belong to the manifest.
+func (c *Client) restoreSegment(ctx context.Context, req *RestoreDBRequest, seg BackupSegment, body io.Reader) (bool, error) {
+	type result struct {
+		done bool
+		err  error // Set if the snapshot is invalid
+	}
+
+	r, w := io.Pipe()
+	results := make(chan result, 1)
+	go func() {
+		changes, last := NewDBChangeReader(body), seg.Since
+		for {
+			change, ok := changes.Next()
+			if !ok {
+				err := changes.Err()
+				if err == nil && last != seg.Commit {
+					err = fmt.Errorf("kms: backup segment '%s' ends at commit %d but should end at commit %d", seg.Name, last, seg.Commit)
+				}
+				results <- result{err: err}
+				w.CloseWithError(err)
+				return
+			}
+			if change.Commit <= last || change.Commit > seg.Commit {
+				err := fmt.Errorf("kms: backup segment '%s' contains commit %d outside of its range (%d, %d]", seg.Name, change.Commit, seg.Since, seg.Commit)
+				results <- result{err: err}
+				w.CloseWithError(err)
+				return
+			}
+			if (req.Commit > 0 && change.Commit > req.Commit) || (!req.Time.IsZero() && change.Time.After(req.Time)) {
+				results <- result{done: true}
+				w.Close()
+				return
+			}
+			if err := writeDBChange(w, &change); err != nil {
+				results <- result{} // WriteDB has returned early and reports the error
+				w.CloseWithError(err)
+				return
+			}
+			last = change.Commit
+		}
+	}()
+
+	err := c.WriteDB(ctx, &WriteDBRequest{
+		Host:  req.Host,
+		Since: seg.Since,
+		Body:  r,
+	})
+	r.Close() // Unblock the writer if WriteDB returned early
+
+	res := <-results
+	if res.err != nil {
+		return false, hostError(req.Host, res.err)
+	}
+	return res.done, err
+}
@@ -0,0 +1,295 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openstor/kms-go/kms/internal/api"
+)
+
+func TestDBChangeReader(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	changes := []DBChange{
+		{Commit: 1, Time: now, Data: []byte("a")},
+		{Commit: 2, Time: now.Add(time.Second), Data: bytes.Repeat([]byte("b"), 1024)},
+		{Commit: 3, Time: now.Add(2 * time.Second)},
+	}
+
+	var buf bytes.Buffer
+	for i := range changes {
+		if err := writeDBChange(&buf, &changes[i]); err != nil {
+			t.Fatalf("Test %d: failed to write DBChange: %v", i, err)
+		}
+	}
+
+	r := NewDBChangeReader(&buf)
+	for i, want := range changes {
+		got, ok := r.Next()
+		if !ok {
+			t.Fatalf("Test %d: failed to read DBChange: %v", i, r.Err())
+		}
+		if got.Commit != want.Commit || !got.Time.Equal(want.Time) || !bytes.Equal(got.Data, want.Data) {
+			t.Fatalf("Test %d: DBChange mismatch: got '%v' - want '%v'", i, got, want)
+		}
+	}
+	if _, ok := r.Next(); ok {
+		t.Fatal("Read DBChange beyond end of stream")
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("Failed to read DBChanges: %v", err)
+	}
+}
+
+func TestBackupManifest_Verify(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range verifyBackupManifestTests {
+		err := test.Manifest.Verify()
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: should have failed to verify manifest", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to verify manifest: %v", i, err)
+		}
+	}
+}
+
+var verifyBackupManifestTests = []struct {
+	Manifest   BackupManifest
+	ShouldFail bool
+}{
+	{
+		Manifest: BackupManifest{Segments: []BackupSegment{
+			{Name: "full", Commit: 10},
+		}},
+	},
+	{
+		Manifest: BackupManifest{Segments: []BackupSegment{
+			{Name: "full", Commit: 10},
+			{Name: "inc-1", Since: 10, Commit: 15},
+			{Name: "inc-2", Since: 15, Commit: 15},
+		}},
+	},
+	{
+		Manifest:   BackupManifest{}, // Empty chain
+		ShouldFail: true,
+	},
+	{
+		Manifest: BackupManifest{Segments: []BackupSegment{
+			{Name: "inc-1", Since: 10, Commit: 15}, // No full snapshot
+		}},
+		ShouldFail: true,
+	},
+	{
+		Manifest: BackupManifest{Segments: []BackupSegment{
+			{Name: "full", Commit: 10},
+			{Name: "inc-2", Since: 15, Commit: 20}, // Gap in chain
+		}},
+		ShouldFail: true,
+	},
+	{
+		Manifest: BackupManifest{Segments: []BackupSegment{
+			{Name: "full", Commit: 10},
+			{Name: "full-2", Commit: 20}, // Full snapshot within chain
+		}},
+		ShouldFail: true,
+	},
+}
+
+func TestClient_RestoreDB(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		writes []restoreDBWrite
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != api.PathDB {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil { // The client aborted the snapshot
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var wr restoreDBWrite
+		if s := r.URL.Query().Get(api.QuerySince); s != "" {
+			if wr.Since, err = strconv.ParseUint(s, 10, 64); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			changes := NewDBChangeReader(bytes.NewReader(body))
+			for change, ok := changes.Next(); ok; change, ok = changes.Next() {
+				wr.Commits = append(wr.Commits, change.Commit)
+			}
+			if changes.Err() != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		mu.Lock()
+		writes = append(writes, wr)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	host := server.Listener.Addr().String()
+	client := newTestClient(t, server, []string{host})
+
+	for i, test := range restoreDBTests {
+		mu.Lock()
+		writes = writes[:0]
+		mu.Unlock()
+
+		err := client.RestoreDB(context.Background(), &RestoreDBRequest{
+			Host:     host,
+			Manifest: &BackupManifest{Segments: test.Manifest},
+			Open: func(_ context.Context, seg BackupSegment) (io.ReadCloser, error) {
+				data, ok := test.Data[seg.Name]
+				if !ok {
+					data = restoreDBData[seg.Name]
+				}
+				return io.NopCloser(bytes.NewReader(data)), nil
+			},
+			Commit: test.Commit,
+			Time:   test.Time,
+		})
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: restore should have failed", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to restore: %v", i, err)
+		}
+		if err != nil && AsHostError(err) == nil {
+			t.Fatalf("Test %d: error is not a HostError: %v", i, err)
+		}
+
+		mu.Lock()
+		got := slices.Clone(writes)
+		mu.Unlock()
+		if len(got) != len(test.Writes) {
+			t.Fatalf("Test %d: write mismatch: got %v - want %v", i, got, test.Writes)
+		}
+		for j := range got {
+			if got[j].Since != test.Writes[j].Since || !slices.Equal(got[j].Commits, test.Writes[j].Commits) {
+				t.Fatalf("Test %d: write %d mismatch: got %v - want %v", i, j, got[j], test.Writes[j])
+			}
+		}
+	}
+}
+
+// restoreDBWrite is a snapshot received by the server. For
+// incremental snapshots, it contains their commit indices.
+type restoreDBWrite struct {
+	Since   uint64
+	Commits []uint64
+}
+
+// restoreDBTime is the commit time of the commit index 0.
+// Each subsequent commit happens one second later.
+var restoreDBTime = time.Unix(1700000000, 0).UTC()
+
+var restoreDBManifest = []BackupSegment{
+	{Name: "full", Commit: 2, CreatedAt: restoreDBTime.Add(2 * time.Second)},
+	{Name: "inc-1", Since: 2, Commit: 4},
+	{Name: "inc-2", Since: 4, Commit: 6},
+}
+
+var restoreDBData = map[string][]byte{
+	"full":  []byte("full snapshot"),
+	"inc-1": encodeDBChanges(3, 4),
+	"inc-2": encodeDBChanges(5, 6),
+}
+
+var restoreDBTests = []struct {
+	Manifest   []BackupSegment
+	Data       map[string][]byte // Replaces restoreDBData
+	Commit     uint64
+	Time       time.Time
+	Writes     []restoreDBWrite
+	ShouldFail bool
+}{
+	{ // 0
+		Manifest: restoreDBManifest,
+		Writes:   []restoreDBWrite{{}, {2, []uint64{3, 4}}, {4, []uint64{5, 6}}},
+	},
+	{ // 1
+		Manifest: restoreDBManifest,
+		Commit:   5,
+		Writes:   []restoreDBWrite{{}, {2, []uint64{3, 4}}, {4, []uint64{5}}},
+	},
+	{ // 2
+		Manifest: restoreDBManifest,
+		Time:     restoreDBTime.Add(3 * time.Second),
+		Writes:   []restoreDBWrite{{}, {2, []uint64{3}}},
+	},
+	{ // 3 - Target before end of full snapshot
+		Manifest:   restoreDBManifest,
+		Commit:     1,
+		ShouldFail: true,
+	},
+	{ // 4 - Gap in chain
+		Manifest:   []BackupSegment{restoreDBManifest[0], restoreDBManifest[2]},
+		ShouldFail: true,
+	},
+	{ // 5 - Segment ends before the commit of its manifest entry
+		Manifest: []BackupSegment{
+			restoreDBManifest[0],
+			{Name: "inc-1", Since: 2, Commit: 5},
+		},
+		Writes:     []restoreDBWrite{{}},
+		ShouldFail: true,
+	},
+	{ // 6 - Segment of another backup chain
+		Manifest:   restoreDBManifest,
+		Data:       map[string][]byte{"inc-1": encodeDBChanges(7, 8)},
+		Writes:     []restoreDBWrite{{}},
+		ShouldFail: true,
+	},
+	{ // 7 - Segment truncated within a state change
+		Manifest:   restoreDBManifest,
+		Data:       map[string][]byte{"inc-1": encodeDBChanges(3, 4)[:len(encodeDBChanges(3, 4))-3]},
+		Writes:     []restoreDBWrite{{}},
+		ShouldFail: true,
+	},
+	{ // 8 - Segment truncated after a state change
+		Manifest:   restoreDBManifest,
+		Data:       map[string][]byte{"inc-1": encodeDBChanges(3)},
+		Writes:     []restoreDBWrite{{}},
+		ShouldFail: true,
+	},
+}
+
+// encodeDBChanges returns an incremental snapshot containing
+// one state change per commit index.
+func encodeDBChanges(commits ...uint64) []byte {
+	var buf bytes.Buffer
+	for _, commit := range commits {
+		change := DBChange{
+			Commit: commit,
+			Time:   restoreDBTime.Add(time.Duration(commit) * time.Second),
+			Data:   []byte(strconv.FormatUint(commit, 10)),
+		}
+		if err := writeDBChange(&buf, &change); err != nil {
+			panic(err)
+		}
+	}
+	return buf.Bytes()
+}
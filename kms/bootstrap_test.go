@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/kmstest"
+)
+
+// The fake servers run in-process. Replication lag is simulated
+// by kmstest.Config.CatchUp and failed nodes by closing a server.
+// TestClient_Bootstrap_Crash covers nodes that crash.
+func TestClient_Bootstrap(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range bootstrapTests {
+		servers := make([]*kmstest.Server, 0, len(test.Nodes))
+		for _, conf := range test.Nodes {
+			s := kmstest.NewServer(&conf)
+			defer s.Close()
+			servers = append(servers, s)
+		}
+
+		seed, hosts := servers[0], make([]string, 0, len(servers)-1)
+		for _, s := range servers[1:] {
+			hosts = append(hosts, s.Addr)
+		}
+		client := kmstest.NewClient(t, servers...)
+		if test.Enclave >= 0 {
+			if err := kmstest.NewClient(t, servers[test.Enclave]).CreateEnclave(context.Background(), &kms.CreateEnclaveRequest{Name: "my-enclave"}); err != nil {
+				t.Fatalf("Test %d: failed to create enclave: %v", i, err)
+			}
+		}
+		if test.Down > 0 {
+			servers[test.Down].Close()
+		}
+
+		opts := &kms.BootstrapOptions{
+			Role:           test.Role,
+			PollInterval:   10 * time.Millisecond,
+			CatchUpTimeout: 500 * time.Millisecond,
+		}
+		err := client.Bootstrap(context.Background(), seed.Addr, hosts, opts)
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: bootstrap should have failed", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to bootstrap cluster: %v", i, err)
+		}
+
+		want := []string{seed.Addr}
+		if !test.ShouldFail {
+			want = append(want, hosts...)
+		}
+		slices.Sort(want)
+		if nodes := seed.Nodes(); !slices.Equal(nodes, want) {
+			t.Fatalf("Test %d: cluster mismatch: got '%v' - want '%v'", i, nodes, want)
+		}
+		if test.ShouldFail {
+			for _, s := range servers[1:] {
+				if nodes := s.Nodes(); len(nodes) != 1 {
+					t.Fatalf("Test %d: server '%s' has not been rolled back: %v", i, s.Addr, nodes)
+				}
+			}
+			continue
+		}
+
+		status, err := client.ClusterStatus(context.Background(), &kms.ClusterStatusRequest{})
+		if err != nil {
+			t.Fatalf("Test %d: failed to fetch cluster status: %v", i, err)
+		}
+		role := test.Role
+		if role == 0 {
+			role = kms.NodeVoter
+		}
+		for _, node := range status.NodesUp {
+			if node.Host != seed.Addr && node.NodeRole != role {
+				t.Fatalf("Test %d: node '%s' has role '%v' - want '%v'", i, node.Host, node.NodeRole, role)
+			}
+		}
+
+		// Bootstrapping an existing cluster again is a no-op.
+		if err = client.Bootstrap(context.Background(), seed.Addr, hosts, opts); err != nil {
+			t.Fatalf("Test %d: failed to bootstrap cluster again: %v", i, err)
+		}
+	}
+}
+
+var bootstrapTests = []struct {
+	Nodes      []kmstest.Config
+	Role       kms.NodeRole
+	Enclave    int // Index of a server with an enclave or -1
+	Down       int // Index of a server that is down or 0
+	ShouldFail bool
+}{
+	{ // 0
+		Nodes:   []kmstest.Config{{}, {CatchUp: 50 * time.Millisecond}, {CatchUp: 20 * time.Millisecond}},
+		Enclave: -1,
+	},
+	{ // 1
+		Nodes:   []kmstest.Config{{}, {}, {}},
+		Role:    kms.NodeLearner,
+		Enclave: 0, // The seed may contain enclaves
+	},
+	{ // 2
+		Nodes:      []kmstest.Config{{}, {}, {Version: "2024-01-01T00-00-00Z"}},
+		Enclave:    -1,
+		ShouldFail: true,
+	},
+	{ // 3
+		Nodes:      []kmstest.Config{{FIPS140: true}, {FIPS140: true}, {}},
+		Enclave:    -1,
+		ShouldFail: true,
+	},
+	{ // 4
+		Nodes:      []kmstest.Config{{}, {}, {}},
+		Enclave:    2,
+		ShouldFail: true,
+	},
+	{ // 5
+		Nodes:      []kmstest.Config{{}, {}, {}},
+		Enclave:    -1,
+		Down:       1,
+		ShouldFail: true,
+	},
+	{ // 6
+		Nodes:      []kmstest.Config{{}, {}, {CatchUp: time.Hour}},
+		Enclave:    -1,
+		ShouldFail: true,
+	},
+}
+
+// The fake nodes run in their own processes. Each one may crash,
+// i.e. exit, at a specific step of bootstrapping the cluster.
+func TestClient_Bootstrap_Crash(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range bootstrapCrashTests {
+		var nodes []*fakeNode
+		for _, env := range test.Nodes {
+			nodes = append(nodes, startNode(t, append(env, envCatchUp+"=20ms")...))
+		}
+		seed, hosts := nodes[0], []string{nodes[1].Addr, nodes[2].Addr}
+
+		client := newNodeClient(t, seed)
+		err := client.Bootstrap(context.Background(), seed.Addr, hosts, &kms.BootstrapOptions{
+			PollInterval:   10 * time.Millisecond,
+			CatchUpTimeout: 500 * time.Millisecond,
+		})
+		if err == nil && test.Err != "" {
+			t.Fatalf("Test %d: bootstrap should have failed", i)
+		}
+		if err != nil && (test.Err == "" || !strings.Contains(err.Error(), test.Err)) {
+			t.Fatalf("Test %d: error mismatch: got '%v' - want '%s'", i, err, test.Err)
+		}
+
+		for j, env := range test.Nodes {
+			// Give crashing nodes some time to exit but don't
+			// wait for nodes that should still be up.
+			crashed, timeout := len(env) > 0, time.Duration(0)
+			if crashed {
+				timeout = time.Second
+			}
+			if crashed != nodes[j].Exited(timeout) {
+				t.Fatalf("Test %d: node %d should have crashed: %v - crashed: %v", i, j, crashed, !crashed)
+			}
+		}
+		if len(test.Nodes[0]) > 0 { // The seed has crashed
+			continue
+		}
+
+		// After a failed Bootstrap, all nodes that are still up
+		// must have been removed from the seed's cluster again.
+		status, err := client.ClusterStatus(context.Background(), &kms.ClusterStatusRequest{})
+		if err != nil {
+			t.Fatalf("Test %d: failed to fetch cluster status: %v", i, err)
+		}
+		if n, want := len(status.NodesUp)+len(status.NodesDown), len(test.Nodes); test.Err != "" && n != 1 || test.Err == "" && n != want {
+			t.Fatalf("Test %d: cluster size mismatch: got %d - nodes up: %v - nodes down: %v", i, n, status.NodesUp, status.NodesDown)
+		}
+		if test.Err == "" {
+			continue
+		}
+		for j, node := range nodes[1:] {
+			if len(test.Nodes[j+1]) > 0 {
+				continue
+			}
+			status, err := newNodeClient(t, node).ClusterStatus(context.Background(), &kms.ClusterStatusRequest{})
+			if err != nil {
+				t.Fatalf("Test %d: failed to fetch cluster status of node %d: %v", i, j+1, err)
+			}
+			if n := len(status.NodesUp) + len(status.NodesDown); n != 1 {
+				t.Fatalf("Test %d: node %d has not been rolled back: cluster size %d", i, j+1, n)
+			}
+		}
+	}
+}
+
+var bootstrapCrashTests = []struct {
+	Nodes [3][]string // Environment of the seed and both nodes
+	Err   string      // Part of the expected error, if any
+}{
+	{ // 0
+		Nodes: [3][]string{},
+	},
+	{ // 1
+		Nodes: [3][]string{2: {envCrash + "=" + crashOnJoin}},
+		Err:   "is not reachable",
+	},
+	{ // 2
+		Nodes: [3][]string{1: {envCrash + "=" + crashJoined}},
+		Err:   "did not catch up",
+	},
+	{ // 3
+		Nodes: [3][]string{2: {envCrash + "=" + crashJoined}},
+		Err:   "did not catch up",
+	},
+	{ // 4
+		Nodes: [3][]string{0: {envCrash + "=" + cmds.ClusterPromoteNode.String()}},
+		Err:   "while rolling back",
+	},
+}
@@ -307,3 +307,49 @@ func ExampleClient_Logs() {
 		log.Fatal(err)
 	}
 }
+
+// ExampleClient_QueryLogs shows how to fetch all error log
+// records within a time range, one page at a time.
+func ExampleClient_QueryLogs() {
+	key, err := mtls.ParsePrivateKey("k1:d7cY_5k8HbBGkZpoy2hGmvkxg83QDBXsA_nFXDfTk2E")
+	if err != nil {
+		log.Fatalf("Failed to parse KMS API key: %v", err)
+	}
+
+	client, err := kms.NewClient(&kms.Config{
+		Endpoints: []string{
+			"127.0.0.1:7373",
+		},
+		APIKey: key,
+		TLS: &tls.Config{
+			RootCAs:            nil,   // Use nil for system root CAs or customize
+			InsecureSkipVerify: false, // Don't skip TLS cert verification in prod
+		},
+	})
+	if err != nil {
+		log.Fatalf("Failed to create KMS client: %v", err)
+	}
+
+	now := time.Now()
+	since := time.Date(now.Year(), now.Month(), now.Day(), 2, 0, 0, 0, now.Location())
+	req := &kms.LogRequest{
+		Host:  "127.0.0.1:7373",         // The server to fetch logs from
+		Level: slog.LevelError,          // Fetch only error logs
+		Since: since,                    // Fetch logs between 02:00
+		Until: since.Add(1 * time.Hour), // and 03:00
+		Limit: 100,                      // Fetch 100 records per page
+	}
+	for {
+		var n int
+		for r, err := range client.QueryLogs(context.TODO(), req) {
+			if err != nil {
+				log.Fatalf("Failed to query server logs: %v", err)
+			}
+			fmt.Println(r.Time, r.Message)
+			req.ContinueAt, n = r.Cursor, n+1
+		}
+		if n < req.Limit {
+			break
+		}
+	}
+}
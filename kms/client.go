@@ -11,7 +11,10 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
+	"iter"
+	"maps"
 	"net"
 	"net/http"
 	"net/url"
@@ -39,6 +42,21 @@ type Config struct {
 	// all endpoints.
 	Endpoints []string
 
+	// Optional list of KMS learner node endpoints. The
+	// Client sends read-only commands, like Decrypt or
+	// KeyStatus, to these endpoints. For example, to
+	// learners within the same region. Commands that
+	// change state are sent to the Endpoints.
+	//
+	// If none of the learners is reachable, or a learner
+	// responds with a 404 or 5xx error, the Client falls
+	// back to the Endpoints.
+	//
+	// Learners may lag behind the cluster leader. Hence,
+	// read-only commands may not observe the most recent
+	// state changes.
+	Learners []string
+
 	// APIKey to authenticate to the KMS cluster.
 	//
 	// When providing an API key, no TLS.Certificates
@@ -85,13 +103,7 @@ func NewClient(conf *Config) (*Client, error) {
 		}
 	}
 
-	hosts := make([]string, 0, len(conf.Endpoints))
-	for _, endpoint := range conf.Endpoints {
-		endpoint = strings.TrimSpace(endpoint)
-		endpoint = strings.TrimPrefix(endpoint, "http://")
-		endpoint = strings.TrimPrefix(endpoint, "https://")
-		hosts = append(hosts, endpoint)
-	}
+	hosts := trimEndpoints(conf.Endpoints)
 	if len(hosts) == 0 {
 		hosts = []string{"127.0.0.1:7373"}
 	}
@@ -113,11 +125,33 @@ func NewClient(conf *Config) (*Client, error) {
 			TLSClientConfig:       tlsConf,
 		},
 	}
-	return &Client{
+
+	client := &Client{
 		direct: http.Client{Transport: lb.RoundTripper},
 		client: http.Client{Transport: lb},
 		lb:     lb,
-	}, nil
+	}
+	if hosts := trimEndpoints(conf.Learners); len(hosts) > 0 {
+		client.learners = &https.LoadBalancer{
+			Hosts:        hosts,
+			RoundTripper: lb.RoundTripper,
+		}
+		client.learnerClient = http.Client{Transport: client.learners}
+	}
+	return client, nil
+}
+
+// trimEndpoints returns the list of endpoints without
+// leading or trailing whitespaces and URI schemes.
+func trimEndpoints(endpoints []string) []string {
+	hosts := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		endpoint = strings.TrimSpace(endpoint)
+		endpoint = strings.TrimPrefix(endpoint, "http://")
+		endpoint = strings.TrimPrefix(endpoint, "https://")
+		hosts = append(hosts, endpoint)
+	}
+	return hosts
 }
 
 // Client is a KMS client. It performs client-side load balancing
@@ -133,6 +167,12 @@ type Client struct {
 
 	client http.Client // Client that uses the LB as RoundTripper
 	lb     *https.LoadBalancer
+
+	// Optional client and LB for read-only commands.
+	// If learners is not nil, read-only commands are
+	// sent to learner nodes first.
+	learnerClient http.Client
+	learners      *https.LoadBalancer
 }
 
 // Hosts returns a list of KMS servers currently used by client.
@@ -147,11 +187,151 @@ func (c *Client) Hosts() []string { return slices.Clone(c.lb.Hosts) }
 // communicate with one particular KMS server, req.Host should be set
 // to the server host or host:port.
 //
+// If the Client has been configured with learner nodes and req.Host
+// is empty, requests that only contain read-only commands are sent
+// to one of the learners. If no learner is reachable, or the learner
+// responds with a 404 or 5xx error, for example because it has not
+// caught up with the leader yet, the request is sent to one of the
+// regular KMS servers.
+//
 // Send is a low-level API. Most callers should use higher-level
 // functionality, like creating a key using CreateKey.
 //
 // The returned error is of type *HostError.
 func (c *Client) Send(ctx context.Context, req *Request) (*http.Response, error) {
+	return c.sendOp(ctx, "Send", req)
+}
+
+// DryRun validates the commands of a KMS request without executing
+// them. The KMS server authenticates the request and checks policies
+// and preconditions of each command, but does not change any state.
+//
+// DryRun returns one DryRunResponse per command in req.Body, in the
+// same order. Each command is validated against the current state
+// independently of the other commands. Hence, a batch that creates
+// and then uses a key reports that the key does not exist.
+//
+// Client methods that perform destructive or privileged operations,
+// like DeleteKey or RemoveNode, support validating a single command
+// with a DryRun request option.
+//
+// Servers without dry-run support reject the request. DryRun returns
+// ErrDryRunNotSupported, wrapped in a HostError, if a server responds
+// without confirming the dry-run.
+//
+// The returned error is of type *HostError. The error of each
+// DryRunResponse is of type *HostError as well.
+func (c *Client) DryRun(ctx context.Context, req *Request) ([]*DryRunResponse, error) {
+	r := *req
+	r.DryRun = true
+
+	resp, err := c.sendOp(ctx, "DryRun", &r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodeDryRunResponse(resp, req.Body)
+}
+
+// dryRunOp validates the single command of req on behalf of the
+// Client method op. It returns the error the command would fail
+// with, if any.
+func (c *Client) dryRunOp(ctx context.Context, op string, req *Request) error {
+	req.DryRun = true
+
+	resp, err := c.sendOp(ctx, op, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	results, err := decodeDryRunResponse(resp, req.Body)
+	if err != nil {
+		return err
+	}
+	return results[0].Err
+}
+
+// sendOp executes a KMS request on behalf of the Client method op.
+// If the request fails, it returns a *HostError that carries an
+// *OpError containing all attempts to send the request.
+func (c *Client) sendOp(ctx context.Context, op string, req *Request) (*http.Response, error) {
+	var attempts []https.Attempt
+	ctx = https.RecordAttempts(ctx, &attempts)
+
+	resp, err := c.sendReq(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+
+	hostErr := AsHostError(err)
+	if hostErr == nil {
+		hostErr = &HostError{Host: req.Host, Err: err}
+	}
+
+	cmd, batch := cmds.Inspect(req.Body)
+	opErr := &OpError{
+		Op:       op,
+		Cmd:      cmd,
+		Enclave:  req.Enclave,
+		Batch:    batch,
+		Attempts: make([]Attempt, 0, len(attempts)),
+		Err:      hostErr.Err,
+	}
+	for _, a := range attempts {
+		opErr.Attempts = append(opErr.Attempts, Attempt{
+			Host:      a.Host,
+			Err:       a.Err,
+			Duration:  a.Duration,
+			Suspended: a.Suspended,
+		})
+	}
+
+	// Without the load balancer, for example when req.Host is set,
+	// no attempts are recorded. If the KMS server responded with an
+	// API error, the last attempt succeeded at the transport level.
+	if n := len(opErr.Attempts); n > 0 {
+		last := &opErr.Attempts[n-1]
+		if last.Err == nil {
+			last.Err = hostErr.Err
+		}
+		hostErr.Host = last.Host // The load balancer may have retried on other hosts
+	} else {
+		opErr.Attempts = append(opErr.Attempts, Attempt{
+			Host: strings.TrimPrefix(hostErr.Host, "https://"),
+			Err:  hostErr.Err,
+		})
+	}
+	hostErr.op = opErr
+	return nil, hostErr
+}
+
+// sendReq executes a KMS request. If the Client has been configured
+// with learner nodes, it sends read-only requests to learners first.
+func (c *Client) sendReq(ctx context.Context, req *Request) (*http.Response, error) {
+	if req.Host == "" && c.learners != nil && cmds.ReadOnly(req.Body) {
+		resp, err := c.send(ctx, &c.learnerClient, c.learners, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		// Learners may lag behind the leader. Hence, a learner
+		// may not know about a key that has just been created
+		// or may be unavailable while catching up. Only API
+		// errors that the regular KMS servers would return as
+		// well, like ErrPermission, are returned as is.
+		var apiErr Error
+		if ctx.Err() != nil || errors.As(err, &apiErr) && apiErr.Code != http.StatusNotFound && apiErr.Code < http.StatusInternalServerError {
+			return nil, err
+		}
+	}
+	return c.send(ctx, &c.client, c.lb, req)
+}
+
+// send executes a KMS request. Without req.Host, it uses
+// the client and its load balancer lb.
+func (c *Client) send(ctx context.Context, client *http.Client, lb *https.LoadBalancer, req *Request) (*http.Response, error) {
 	const (
 		Method   = http.MethodPost
 		Path     = "/v1/kms/"
@@ -164,7 +344,7 @@ func (c *Client) Send(ctx context.Context, req *Request) (*http.Response, error)
 		host   = req.Host
 	)
 	if host == "" {
-		reqURL, host, err = c.lb.URL(Path, req.Enclave)
+		reqURL, host, err = lb.URL(Path, req.Enclave)
 	} else {
 		reqURL, err = url.JoinPath(httpsURL(host), Path, req.Enclave)
 	}
@@ -172,17 +352,27 @@ func (c *Client) Send(ctx context.Context, req *Request) (*http.Response, error)
 		return nil, hostError(host, err)
 	}
 
-	r, err := http.NewRequestWithContext(ctx, Method, reqURL, bytes.NewReader(req.Body))
+	// Dry-run commands are wrapped into a DRYRUN command. Servers
+	// that don't support dry-runs reject the unknown command instead
+	// of executing the wrapped commands.
+	body := req.Body
+	if req.DryRun {
+		if body, err = cmds.EncodePB(nil, cmds.DryRun, &pb.DryRunRequest{Body: req.Body}); err != nil {
+			return nil, hostError(host, err)
+		}
+	}
+
+	r, err := http.NewRequestWithContext(ctx, Method, reqURL, bytes.NewReader(body))
 	if err != nil {
 		return nil, hostError(host, err)
 	}
-	r.ContentLength = int64(len(req.Body))
+	r.ContentLength = int64(len(body))
 	r.Header.Add(headers.Accept, headers.ContentTypeAppAny) // accept binary and json
 	r.Header.Set(headers.ContentType, headers.ContentTypeBinary)
 
 	var resp *http.Response
 	if req.Host == "" {
-		resp, err = c.client.Do(r) // Without req.Host, use the client LB.
+		resp, err = client.Do(r) // Without req.Host, use the client LB.
 	} else {
 		resp, err = c.direct.Do(r) // With an explicit req.Host, don't use client LB.
 	}
@@ -194,6 +384,10 @@ func (c *Client) Send(ctx context.Context, req *Request) (*http.Response, error)
 
 		return nil, hostError(host, readError(resp))
 	}
+	if req.DryRun && resp.Header.Get(headers.KMSDryRun) != "true" {
+		resp.Body.Close()
+		return nil, hostError(host, ErrDryRunNotSupported)
+	}
 	return resp, nil
 }
 
@@ -671,7 +865,7 @@ func (c *Client) ClusterStatus(ctx context.Context, _ *ClusterStatusRequest) (*C
 		return nil, err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "ClusterStatus", &Request{
 		Body: body,
 	})
 	if err != nil {
@@ -709,7 +903,7 @@ func (c *Client) EditCluster(ctx context.Context, req *EditClusterRequest) error
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "EditCluster", &Request{
 		Host: req.Host,
 		Body: body,
 	})
@@ -719,6 +913,164 @@ func (c *Client) EditCluster(ctx context.Context, req *EditClusterRequest) error
 	return resp.Body.Close()
 }
 
+// SetClusterTiming changes the heartbeat interval and base election
+// timeout of all KMS servers within the cluster. If heartbeat or
+// electionTimeout is 0, the corresponding value remains unchanged.
+//
+// All nodes within a cluster should use the same timing. Hence,
+// SetClusterTiming refuses to change the timing if any node is down.
+// The resulting election timeout must be at least twice as long as
+// the resulting heartbeat interval to prevent nodes from starting
+// elections even though a leader is present.
+//
+// SetClusterTiming edits the cluster definition of each node one
+// after another. If it fails to edit the cluster definition of a
+// node, some nodes may already use the new timing. In this case,
+// the returned error is a *ClusterEditError that reports which
+// nodes have been edited.
+//
+// It requires SysAdmin privileges.
+//
+// The returned error is of type *ClusterEditError or *HostError.
+func (c *Client) SetClusterTiming(ctx context.Context, heartbeat, electionTimeout time.Duration) error {
+	if heartbeat < 0 {
+		return hostError("", errors.New("kms: heartbeat interval is negative"))
+	}
+	if electionTimeout < 0 {
+		return hostError("", errors.New("kms: election timeout is negative"))
+	}
+	if heartbeat == 0 && electionTimeout == 0 {
+		return nil
+	}
+
+	status, err := c.ClusterStatus(ctx, &ClusterStatusRequest{})
+	if err != nil {
+		return err
+	}
+	for _, addr := range status.NodesDown {
+		return hostError(addr, errors.New("kms: node is down"))
+	}
+	for _, node := range status.NodesUp {
+		h, e := heartbeat, electionTimeout
+		if h == 0 {
+			h = node.HeartbeatInterval
+		}
+		if e == 0 {
+			e = node.ElectionTimeout
+		}
+		if e < 2*h {
+			return hostError(node.Host, errors.New("kms: election timeout is less than twice the heartbeat interval"))
+		}
+	}
+
+	hosts := make([]string, 0, len(status.NodesUp))
+	for _, id := range slices.Sorted(maps.Keys(status.NodesUp)) {
+		hosts = append(hosts, status.NodesUp[id].Host)
+	}
+	return c.editClusters(ctx, hosts, &EditClusterRequest{
+		HeartbeatInterval: heartbeat,
+		ElectionTimeout:   electionTimeout,
+	})
+}
+
+// UpdateNodeAddr changes the address of the KMS server with the given
+// node ID to addr within the cluster definition of all KMS servers.
+// The node ID remains unchanged. For example, when the IP address
+// of a KMS server changes, there is no need to remove it from the
+// cluster and add it again.
+//
+// It returns ErrNodeNotFound if no such node exists and an error if
+// addr is already used by another node. All other nodes must be up.
+// If the node itself is down, UpdateNodeAddr tries to reach it at
+// addr instead.
+//
+// UpdateNodeAddr edits the cluster definition of the node itself first
+// and then of all other nodes, one after another. If it fails to edit
+// the cluster definition of a node, the returned error is a
+// *ClusterEditError that reports which nodes have been edited already.
+// Calling UpdateNodeAddr again completes the update.
+//
+// UpdateNodeAddr does not change the client's list of hosts. Requests
+// to the old address fail once the node is no longer reachable there.
+// Applications should create a new Client with the new address.
+//
+// It requires SysAdmin privileges.
+//
+// The returned error is of type *ClusterEditError or *HostError.
+func (c *Client) UpdateNodeAddr(ctx context.Context, id int, addr string) error {
+	addr = strings.TrimSpace(addr)
+	addr = strings.TrimPrefix(addr, "http://")
+	addr = strings.TrimPrefix(addr, "https://")
+	addr = strings.TrimSuffix(addr, "/")
+	if addr == "" {
+		return hostError("", errors.New("kms: node address is empty"))
+	}
+
+	status, err := c.ClusterStatus(ctx, &ClusterStatusRequest{})
+	if err != nil {
+		return err
+	}
+
+	nodes := make(map[int]string, len(status.NodesUp)+len(status.NodesDown))
+	for nodeID, node := range status.NodesUp {
+		nodes[nodeID] = node.Host
+	}
+	maps.Copy(nodes, status.NodesDown)
+
+	oldAddr, ok := nodes[id]
+	if !ok {
+		return hostError("", ErrNodeNotFound)
+	}
+	if oldAddr == addr {
+		return nil
+	}
+	for nodeID, nodeAddr := range nodes {
+		if nodeID != id && nodeAddr == addr {
+			return hostError(addr, errors.New("kms: address is used by node '"+strconv.Itoa(nodeID)+"'"))
+		}
+	}
+	for nodeID, nodeAddr := range status.NodesDown {
+		if nodeID != id {
+			return hostError(nodeAddr, errors.New("kms: node is down"))
+		}
+	}
+
+	// Update the node itself first. If it is not reachable,
+	// no other node gets updated. A node that is down may
+	// be reachable at its new address already.
+	hosts := []string{addr}
+	if node, ok := status.NodesUp[id]; ok {
+		hosts[0] = node.Host
+	}
+	for _, nodeID := range slices.Sorted(maps.Keys(status.NodesUp)) {
+		if nodeID != id {
+			hosts = append(hosts, status.NodesUp[nodeID].Host)
+		}
+	}
+	return c.editClusters(ctx, hosts, &EditClusterRequest{
+		Update: map[int]string{id: addr},
+	})
+}
+
+// editClusters edits the cluster definition of all hosts one after
+// another. It returns a *ClusterEditError if editing fails.
+func (c *Client) editClusters(ctx context.Context, hosts []string, req *EditClusterRequest) error {
+	edited := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		r := *req
+		r.Host = host
+		if err := c.EditCluster(ctx, &r); err != nil {
+			return &ClusterEditError{
+				Edited: edited,
+				Host:   host,
+				Err:    err,
+			}
+		}
+		edited = append(edited, host)
+	}
+	return nil
+}
+
 // AddNode adds the KMS server at req.Host to the current KMS cluster.
 // It returns an error if the server is already part of the cluster.
 //
@@ -726,6 +1078,10 @@ func (c *Client) EditCluster(ctx context.Context, req *EditClusterRequest) error
 // server at req.Host must be fresh in the sense that it must not be
 // part of a multi-node cluster already.
 //
+// If req.Role is NodeLearner, the KMS server joins the cluster as
+// non-voting learner. Learners don't affect the write quorum and
+// can be promoted to voters later on. Refer to PromoteNode.
+//
 // It requires SysAdmin privileges.
 //
 // The returned error is of type *HostError.
@@ -735,7 +1091,7 @@ func (c *Client) AddNode(ctx context.Context, req *AddClusterNodeRequest) error
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "AddNode", &Request{
 		Body: body,
 	})
 	if err != nil {
@@ -750,6 +1106,9 @@ func (c *Client) AddNode(ctx context.Context, req *AddClusterNodeRequest) error
 // RemoveNode removes the KMS server at req.Host from the current KMS
 // cluster. It returns an error if the server is not part of the cluster.
 //
+// If req.DryRun is set, RemoveNode only validates whether the server
+// can be removed and returns the error removing it would fail with.
+//
 // It requires SysAdmin privileges.
 //
 // The returned error is of type *HostError.
@@ -758,8 +1117,11 @@ func (c *Client) RemoveNode(ctx context.Context, req *RemoveClusterNodeRequest)
 	if err != nil {
 		return err
 	}
+	if req.DryRun {
+		return c.dryRunOp(ctx, "RemoveNode", &Request{Body: body})
+	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "RemoveNode", &Request{
 		Body: body,
 	})
 	if err != nil {
@@ -777,10 +1139,164 @@ func (c *Client) RemoveNode(ctx context.Context, req *RemoveClusterNodeRequest)
 	return nil
 }
 
+// PromoteNode promotes the learner node at req.Host to a voter. Once
+// promoted, the KMS server takes part in leader elections and write
+// quorums. It returns an error if the server is not part of the cluster.
+//
+// A learner should only be promoted once it has caught up with the
+// cluster leader. Refer to ClusterStatus.
+//
+// It requires SysAdmin privileges.
+//
+// The returned error is of type *HostError.
+func (c *Client) PromoteNode(ctx context.Context, req *PromoteClusterNodeRequest) error {
+	body, err := cmds.Encode(nil, cmds.ClusterPromoteNode, req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.sendOp(ctx, "PromoteNode", &Request{
+		Body: body,
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// DemoteNode demotes the voter node at req.Host to a learner. Once
+// demoted, the KMS server still replicates the cluster state but no
+// longer takes part in leader elections and write quorums. It returns
+// an error if the server is not part of the cluster.
+//
+// It requires SysAdmin privileges.
+//
+// The returned error is of type *HostError.
+func (c *Client) DemoteNode(ctx context.Context, req *DemoteClusterNodeRequest) error {
+	body, err := cmds.Encode(nil, cmds.ClusterDemoteNode, req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.sendOp(ctx, "DemoteNode", &Request{
+		Body: body,
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// TransferLeadership makes the current cluster leader hand off the
+// cluster leadership to the node with the ID toNodeID and waits until
+// this node reports itself as cluster leader. It returns immediately
+// if the node is the cluster leader already.
+//
+// Transferring the leadership before restarting or removing the
+// current leader avoids an election timeout and, therefore, a
+// period of time in which the cluster cannot process any writes.
+// For example, during rolling restarts.
+//
+// The target node must be a voter, must be up and should have caught
+// up with the current cluster leader. TransferLeadership returns
+// ErrNodeNotFound if no such node exists. The leader refuses to hand
+// off its leadership if the target node lags behind.
+//
+// Applications should limit the time TransferLeadership waits for
+// the new leader using a context deadline. If ctx has no deadline,
+// TransferLeadership waits at most ten times the election timeout
+// of the target node, or 30 seconds if the node doesn't report any.
+//
+// It requires SysAdmin privileges.
+//
+// The returned error is of type *HostError.
+func (c *Client) TransferLeadership(ctx context.Context, toNodeID int) error {
+	status, err := c.ClusterStatus(ctx, &ClusterStatusRequest{})
+	if err != nil {
+		return err
+	}
+	node, ok := status.NodesUp[toNodeID]
+	if !ok {
+		if addr, ok := status.NodesDown[toNodeID]; ok {
+			return hostError(addr, errors.New("kms: node is down"))
+		}
+		return hostError("", ErrNodeNotFound)
+	}
+	if node.Role == "Leader" {
+		return nil
+	}
+	if node.NodeRole == NodeLearner {
+		return hostError(node.Host, errors.New("kms: node is a learner and cannot become leader"))
+	}
+
+	// Without a deadline, we would wait forever if the node never
+	// becomes leader. For example, since it lags behind and the
+	// leader refuses to hand off its leadership.
+	if _, ok := ctx.Deadline(); !ok {
+		timeout := 10 * node.ElectionTimeout
+		if timeout <= 0 {
+			timeout = 30 * time.Second
+		}
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout, fmt.Errorf("kms: node has not become leader within %v", timeout))
+		defer cancel()
+	}
+
+	body, err := cmds.Encode(nil, cmds.ClusterTransferLeader, &TransferLeadershipRequest{
+		NodeID: toNodeID,
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := c.sendOp(ctx, "TransferLeadership", &Request{
+		Body: body,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	interval := node.HeartbeatInterval
+	if interval <= 0 {
+		interval = 250 * time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return hostError(node.Host, context.Cause(ctx))
+		case <-ticker.C:
+		}
+
+		status, err := c.ClusterStatus(ctx, &ClusterStatusRequest{})
+		if err != nil {
+			// The cluster may be unreachable until the new leader
+			// takes over. However, API errors, like ErrPermission,
+			// won't go away by retrying.
+			var apiErr Error
+			if errors.As(err, &apiErr) {
+				return err
+			}
+			continue
+		}
+		if node, ok := status.NodesUp[toNodeID]; ok && node.Role == "Leader" {
+			return nil
+		}
+	}
+}
+
 // ReadDB returns a snapshot of current KMS server database.
 // If req.Host is empty, one of the client's hosts is used.
 // The returned ReadDBResponse must be closed by the caller.
 //
+// If req.Since is > 0, ReadDB returns an incremental snapshot
+// containing all state changes after the commit index req.Since.
+// An incremental snapshot is a stream of DBChanges. Refer to
+// DBChangeReader.
+//
 // It requires SysAdmin privileges.
 //
 // The returned error is of type *HostError.
@@ -804,6 +1320,9 @@ func (c *Client) ReadDB(ctx context.Context, req *ReadDBRequest) (*ReadDBRespons
 	if err != nil {
 		return nil, hostError(host, err)
 	}
+	if req.Since > 0 {
+		reqURL += "?" + url.Values{api.QuerySince: []string{strconv.FormatUint(req.Since, 10)}}.Encode()
+	}
 
 	r, err := http.NewRequestWithContext(ctx, Method, reqURL, nil)
 	if err != nil {
@@ -827,6 +1346,14 @@ func (c *Client) ReadDB(ctx context.Context, req *ReadDBRequest) (*ReadDBRespons
 		return nil, hostError(host, readError(resp))
 	}
 
+	var commit uint64
+	if v := resp.Header.Get(headers.KMSCommit); v != "" {
+		if commit, err = strconv.ParseUint(v, 10, 64); err != nil {
+			resp.Body.Close()
+			return nil, hostError(host, fmt.Errorf("kms: invalid commit index '%s'", v))
+		}
+	}
+
 	// Decompress the response body if the HTTP client doesn't
 	// decompress automatically.
 	body := resp.Body
@@ -842,13 +1369,21 @@ func (c *Client) ReadDB(ctx context.Context, req *ReadDBRequest) (*ReadDBRespons
 		}
 	}
 	return &ReadDBResponse{
-		Body: body,
+		Body:   body,
+		Since:  req.Since,
+		Commit: commit,
 	}, nil
 }
 
 // WriteDB writes a database snapshot to req.Host. The given
 // host must not be empty.
 //
+// If req.Since is > 0, req.Body must be an incremental snapshot
+// and the KMS server applies its state changes on top of its
+// current database. The database of the KMS server must be at
+// commit index req.Since. For restoring a chain of snapshots
+// refer to RestoreDB.
+//
 // It requires SysAdmin privileges.
 //
 // The returned error is of type *HostError.
@@ -864,6 +1399,9 @@ func (c *Client) WriteDB(ctx context.Context, req *WriteDBRequest) error {
 	if err != nil {
 		return hostError(host, err)
 	}
+	if req.Since > 0 {
+		reqURL += "?" + url.Values{api.QuerySince: []string{strconv.FormatUint(req.Since, 10)}}.Encode()
+	}
 
 	r, err := http.NewRequestWithContext(ctx, Method, reqURL, req.Body)
 	if err != nil {
@@ -897,7 +1435,9 @@ func (c *Client) WriteDB(ctx context.Context, req *WriteDBRequest) error {
 //
 // The LogRequest specifies which log records are fetched.
 // For example, only records with a certain log level or
-// records that contain a specific log message.
+// records that contain a specific log message. Unless the
+// LogRequest specifies an end, using Until, Limit or
+// Descending, the stream is continuous.
 //
 // It's the caller's responsibility to close a LogResponse to
 // release associated resources.
@@ -950,11 +1490,48 @@ func (c *Client) Logs(ctx context.Context, req *LogRequest) (*LogResponse, error
 		return nil, hostError(host, fmt.Errorf("kms: invalid content-type '%s'", ct))
 	}
 	return &LogResponse{
-		r:   resp.Body,
-		buf: make([]byte, 4*mem.KiB),
+		r:    resp.Body,
+		buf:  make([]byte, 4*mem.KiB),
+		host: host,
 	}, nil
 }
 
+// QueryLogs returns a finite sequence of historical server log
+// records from req.Host. If req.Host is empty, one of the client's
+// hosts is used.
+//
+// The LogRequest specifies which log records are fetched. If
+// req.Until is empty, QueryLogs fetches all records up to the
+// current time. For paginating through historical logs, callers
+// can use the Cursor of the last record as req.ContinueAt.
+//
+// The sequence ends after the first error. It requires SysAdmin
+// privileges. Any returned error is of type *HostError.
+func (c *Client) QueryLogs(ctx context.Context, req *LogRequest) iter.Seq2[LogRecord, error] {
+	return func(yield func(LogRecord, error) bool) {
+		r := *req
+		if r.Until.IsZero() {
+			r.Until = time.Now()
+		}
+
+		logs, err := c.Logs(ctx, &r)
+		if err != nil {
+			yield(LogRecord{}, err)
+			return
+		}
+		defer logs.Close()
+
+		for rec, ok := logs.Next(); ok; rec, ok = logs.Next() {
+			if !yield(rec, nil) {
+				return
+			}
+		}
+		if err = logs.Close(); err != nil && !errors.Is(err, io.EOF) {
+			yield(LogRecord{}, hostError(logs.host, err))
+		}
+	}
+}
+
 // AddHSM seals the cluster's on-disk state with the HSM referenced by req.Name.
 //
 // The cluster must already be configured with the HSM. Hence, AddHSM can only
@@ -969,7 +1546,7 @@ func (c *Client) AddHSM(ctx context.Context, req *AddHSMRequest) error {
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "AddHSM", &Request{
 		Body: body,
 	})
 	if err != nil {
@@ -993,7 +1570,7 @@ func (c *Client) RemoveHSM(ctx context.Context, req *RemoveHSMRequest) error {
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "RemoveHSM", &Request{
 		Body: body,
 	})
 	if err != nil {
@@ -1016,7 +1593,7 @@ func (c *Client) CreateEnclave(ctx context.Context, req *CreateEnclaveRequest) e
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "CreateEnclave", &Request{
 		Body: body,
 	})
 	if err != nil {
@@ -1049,7 +1626,7 @@ func (c *Client) EnclaveStatus(ctx context.Context, reqs ...*EnclaveStatusReques
 		}
 	}
 
-	resp, err := c.Send(ctx, &Request{Body: body})
+	resp, err := c.sendOp(ctx, "EnclaveStatus", &Request{Body: body})
 	if err != nil {
 		return nil, err
 	}
@@ -1063,6 +1640,10 @@ func (c *Client) EnclaveStatus(ctx context.Context, reqs ...*EnclaveStatusReques
 // It returns ErrEnclaveNotFound if no such enclave exists
 // wrapped in a HostError.
 //
+// If req.DryRun is set, DeleteEnclave only validates whether the
+// enclave can be deleted and returns the error deleting it would
+// fail with.
+//
 // It requires SysAdmin privileges.
 //
 // The returned error is of type *HostError.
@@ -1071,8 +1652,11 @@ func (c *Client) DeleteEnclave(ctx context.Context, req *DeleteEnclaveRequest) e
 	if err != nil {
 		return err
 	}
+	if req.DryRun {
+		return c.dryRunOp(ctx, "DeleteEnclave", &Request{Body: body})
+	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "DeleteEnclave", &Request{
 		Body: body,
 	})
 	if err != nil {
@@ -1081,23 +1665,51 @@ func (c *Client) DeleteEnclave(ctx context.Context, req *DeleteEnclaveRequest) e
 	return resp.Body.Close()
 }
 
-// ListEnclaves returns the next page of a paginated listing of enclaves.
-// All enclave names start with the req.Prefix and the first enclave name
-// matches req.ContinueAt. The page contains at most req.Limit enclaves.
+// RenameEnclave renames the enclave req.Name to req.NewName. The enclave
+// keeps its keys, policies and identities. Applications have to use the
+// new name once the enclave has been renamed.
 //
-// ListEnclaves implements paginated listing. For iterating over a stream
-// of enclaves combine it with an Iter.
+// It returns ErrEnclaveNotFound if no such enclave exists and
+// ErrEnclaveExists if an enclave with the new name exists already,
+// wrapped in a HostError.
 //
 // It requires SysAdmin privileges.
 //
 // The returned error is of type *HostError.
-func (c *Client) ListEnclaves(ctx context.Context, req *ListRequest) (*Page[EnclaveStatusResponse], error) {
-	body, err := cmds.Encode(nil, cmds.EnclaveList, req)
+func (c *Client) RenameEnclave(ctx context.Context, req *RenameEnclaveRequest) error {
+	body, err := cmds.Encode(nil, cmds.EnclaveRename, req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.sendOp(ctx, "RenameEnclave", &Request{
+		Body: body,
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// ExportEnclave exports the enclave req.Name, including its keys, policies
+// and identities, as encrypted and integrity-protected archive. The keys
+// within the archive are wrapped under req.ExportKey, if present, or under
+// a cluster key otherwise. Only archives exported with an export key can be
+// imported into another cluster.
+//
+// It returns ErrEnclaveNotFound if no such enclave exists wrapped in
+// HostError.
+//
+// It requires SysAdmin privileges.
+//
+// The returned error is of type *HostError.
+func (c *Client) ExportEnclave(ctx context.Context, req *ExportEnclaveRequest) (*ExportEnclaveResponse, error) {
+	body, err := cmds.Encode(nil, cmds.EnclaveExport, req)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "ExportEnclave", &Request{
 		Body: body,
 	})
 	if err != nil {
@@ -1105,17 +1717,76 @@ func (c *Client) ListEnclaves(ctx context.Context, req *ListRequest) (*Page[Encl
 	}
 	defer resp.Body.Close()
 
-	var data pb.ListEnclavesResponse
-	if err := decodeResponseMessage(resp, cmds.EnclaveList, &data); err != nil {
+	data, err := decodeResponse[pb.ExportEnclaveResponse, ExportEnclaveResponse](resp, cmds.EnclaveExport)
+	if err != nil {
 		return nil, err
 	}
+	return data[0], nil
+}
 
-	ls := &Page[EnclaveStatusResponse]{
-		Items:      make([]EnclaveStatusResponse, 0, len(data.Enclaves)),
-		ContinueAt: data.ContinueAt,
-	}
-	for _, e := range data.Enclaves {
-		var r EnclaveStatusResponse
+// ImportEnclave restores an enclave, including its keys, policies and
+// identities, from an archive created by ExportEnclave. The enclave is
+// created with the name req.Name or, if empty, with the name within the
+// archive. Hence, an archive can be imported into the same cluster under
+// a new name or into another cluster.
+//
+// It returns ErrEnclaveExists if such an enclave already exists and
+// ErrDecrypt if the archive has been modified or req.ExportKey does not
+// match the export key, wrapped in HostError.
+//
+// It requires SysAdmin privileges.
+//
+// The returned error is of type *HostError.
+func (c *Client) ImportEnclave(ctx context.Context, req *ImportEnclaveRequest) error {
+	body, err := cmds.Encode(nil, cmds.EnclaveImport, req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.sendOp(ctx, "ImportEnclave", &Request{
+		Body: body,
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// ListEnclaves returns the next page of a paginated listing of enclaves.
+// All enclave names start with the req.Prefix and the first enclave name
+// matches req.ContinueAt. The page contains at most req.Limit enclaves.
+//
+// ListEnclaves implements paginated listing. For iterating over a stream
+// of enclaves combine it with an Iter.
+//
+// It requires SysAdmin privileges.
+//
+// The returned error is of type *HostError.
+func (c *Client) ListEnclaves(ctx context.Context, req *ListRequest) (*Page[EnclaveStatusResponse], error) {
+	body, err := cmds.Encode(nil, cmds.EnclaveList, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendOp(ctx, "ListEnclaves", &Request{
+		Body: body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var data pb.ListEnclavesResponse
+	if err := decodeResponseMessage(resp, cmds.EnclaveList, &data); err != nil {
+		return nil, err
+	}
+
+	ls := &Page[EnclaveStatusResponse]{
+		Items:      make([]EnclaveStatusResponse, 0, len(data.Enclaves)),
+		ContinueAt: data.ContinueAt,
+	}
+	for _, e := range data.Enclaves {
+		var r EnclaveStatusResponse
 		if err = r.UnmarshalPB(e); err != nil {
 			return nil, hostError(resp.Request.URL.Host, err)
 		}
@@ -1144,7 +1815,7 @@ func (c *Client) CreateKey(ctx context.Context, enclave string, req *CreateKeyRe
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "CreateKey", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1171,7 +1842,109 @@ func (c *Client) ImportKey(ctx context.Context, enclave string, req *ImportKeyRe
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "ImportKey", &Request{
+		Enclave: enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// WrappingKey returns the import public key of the KMS cluster. Other
+// KMS clusters wrap exported key material with this public key. Only
+// the KMS cluster in possession of the corresponding private key can
+// import such keys. Refer to ExportKey and ImportWrapKey.
+//
+// The returned error is of type *HostError.
+func (c *Client) WrappingKey(ctx context.Context, enclave string, req *WrappingKeyRequest) (*WrappingKeyResponse, error) {
+	body, err := cmds.Encode(nil, cmds.KeyWrappingKey, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendOp(ctx, "WrappingKey", &Request{
+		Enclave: enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := decodeResponse[pb.WrappingKeyResponse, WrappingKeyResponse](resp, cmds.KeyWrappingKey)
+	if err != nil {
+		return nil, err
+	}
+	return data[0], nil
+}
+
+// ExportKey exports one or multiple key versions within the enclave.
+// The key material of each key version is wrapped with the request's
+// wrapping key and can only be imported by the KMS cluster owning the
+// wrapping key. Without any requests, ExportKey returns an empty slice
+// and no error.
+//
+// Exporting keys is a privileged operation. A policy must allow the
+// KEY:EXPORT command explicitly. It is not covered by any other key
+// permission.
+//
+// The returned error is of type *HostError. If the enclave does not
+// exist, ExportKey returns ErrEnclaveNotFound wrapped in a HostError.
+// Similarly, if at least one key or key version does not exist,
+// ExportKey returns ErrKeyNotFound wrapped in a HostError.
+func (c *Client) ExportKey(ctx context.Context, enclave string, reqs ...*ExportKeyRequest) ([]*ExportKeyResponse, error) {
+	if len(reqs) == 0 {
+		return []*ExportKeyResponse{}, nil
+	}
+
+	p := pool.Get(128 * len(reqs))
+	defer pool.Put(p)
+
+	body := (*p)[:0]
+	for _, req := range reqs {
+		var err error
+		body, err = cmds.Encode(body, cmds.KeyExport, req)
+		if err != nil {
+			return nil, hostError("", err)
+		}
+	}
+
+	resp, err := c.sendOp(ctx, "ExportKey", &Request{
+		Enclave: enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[pb.ExportKeyResponse, ExportKeyResponse](resp, cmds.KeyExport)
+}
+
+// ImportWrapKey imports a key version, exported by another KMS cluster,
+// into the given enclave. The key material must be wrapped with the
+// import public key of this KMS cluster. Refer to WrappingKey.
+//
+// In contrast to ImportKey, the key version, key type and metadata, like
+// the creation time, are preserved. Hence, data encrypted by the original
+// key version can be decrypted by the imported one.
+//
+// It returns ErrEnclaveNotFound if no such enclave exists and ErrKeyExists
+// if such a key version already exists wrapped in a HostError.
+//
+// The returned error is of type *HostError.
+func (c *Client) ImportWrapKey(ctx context.Context, enclave string, req *ImportWrapKeyRequest) error {
+	p := pool.Get(128)
+	defer pool.Put(p)
+
+	body, err := cmds.Encode((*p)[:0], cmds.KeyImportWrap, req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.sendOp(ctx, "ImportWrapKey", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1206,7 +1979,7 @@ func (c *Client) KeyStatus(ctx context.Context, enclave string, reqs ...*KeyStat
 		}
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "KeyStatus", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1226,6 +1999,9 @@ func (c *Client) KeyStatus(ctx context.Context, enclave string, reqs ...*KeyStat
 // It returns ErrEnclaveNotFound if no such enclave exists and ErrKeyNotFound
 // if such key or key version exists, wrapped in a HostError.
 //
+// If req.DryRun is set, DeleteKey only validates whether the key can be
+// deleted and returns the error deleting it would fail with.
+//
 // The returned error is of type *HostError.
 func (c *Client) DeleteKey(ctx context.Context, enclave string, req *DeleteKeyRequest) error {
 	p := pool.Get(128)
@@ -1235,8 +2011,79 @@ func (c *Client) DeleteKey(ctx context.Context, enclave string, req *DeleteKeyRe
 	if err != nil {
 		return err
 	}
+	if req.DryRun {
+		return c.dryRunOp(ctx, "DeleteKey", &Request{Enclave: enclave, Body: body})
+	}
+
+	resp, err := c.sendOp(ctx, "DeleteKey", &Request{
+		Enclave: enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// SetKeyState changes the state of the key version req.Version of the key
+// with the name req.Name within the enclave. It changes the state of the
+// latest key version if no key version is specified.
+//
+// A decrypt-only key version can only be used to decrypt existing
+// ciphertexts. Encrypt, GenerateKey and MAC return ErrKeyVersionDecryptOnly.
+// A disabled key version cannot be used at all and any cryptographic
+// operation returns ErrKeyVersionDisabled. In contrast to DeleteKey,
+// key versions can be re-enabled at any time.
+//
+// It returns ErrEnclaveNotFound if no such enclave exists and ErrKeyNotFound
+// if no such key or key version exists, wrapped in a HostError.
+//
+// The returned error is of type *HostError.
+func (c *Client) SetKeyState(ctx context.Context, enclave string, req *SetKeyStateRequest) error {
+	p := pool.Get(128)
+	defer pool.Put(p)
+
+	body, err := cmds.Encode((*p)[:0], cmds.KeySetState, req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.sendOp(ctx, "SetKeyState", &Request{
+		Enclave: enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// MoveKey moves the key req.Name, with all its versions, from the given
+// enclave to the enclave req.Destination. If req.NewName is set, the key
+// is renamed as well. The move is atomic. The key either exists in the
+// source or in the destination enclave. Since the key material does not
+// change, existing ciphertexts can be decrypted with the moved key.
+//
+// The server checks the KEY:MOVE command against the policies in both
+// enclaves: the key's name within the source and its new name within
+// the destination enclave have to be allowed.
+//
+// It returns ErrEnclaveNotFound if either enclave does not exist,
+// ErrKeyNotFound if no such key exists and ErrKeyExists if the
+// destination enclave contains a key with the same name already,
+// wrapped in a HostError.
+//
+// The returned error is of type *HostError.
+func (c *Client) MoveKey(ctx context.Context, enclave string, req *MoveKeyRequest) error {
+	p := pool.Get(128)
+	defer pool.Put(p)
+
+	body, err := cmds.Encode((*p)[:0], cmds.KeyMove, req)
+	if err != nil {
+		return err
+	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "MoveKey", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1250,8 +2097,15 @@ func (c *Client) DeleteKey(ctx context.Context, enclave string, req *DeleteKeyRe
 // All key names start with the req.Prefix and the first key name matches
 // req.ContinueAt. The page contains at most req.Limit keys.
 //
+// If req.Delimiter is set, keys within nested namespaces are not listed.
+// Instead, the page contains the namespaces directly below req.Prefix
+// as CommonPrefixes. For example, listing with the prefix "team/" and
+// the delimiter "/" returns the key "team/key" and the common prefix
+// "team/app/" for the keys "team/app/key-1" and "team/app/prod/key-2".
+//
 // ListKeys implements paginated listing. For iterating over a stream
-// of keys combine it with an Iter.
+// of keys combine it with an Iter. An Iter does not return common
+// prefixes.
 //
 // The returned error is of type *HostError.
 func (c *Client) ListKeys(ctx context.Context, req *ListRequest) (*Page[KeyStatusResponse], error) {
@@ -1260,7 +2114,7 @@ func (c *Client) ListKeys(ctx context.Context, req *ListRequest) (*Page[KeyStatu
 		return nil, err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "ListKeys", &Request{
 		Enclave: req.Enclave,
 		Body:    body,
 	})
@@ -1275,8 +2129,9 @@ func (c *Client) ListKeys(ctx context.Context, req *ListRequest) (*Page[KeyStatu
 	}
 
 	ls := &Page[KeyStatusResponse]{
-		Items:      make([]KeyStatusResponse, 0, len(data.Keys)),
-		ContinueAt: data.ContinueAt,
+		Items:          make([]KeyStatusResponse, 0, len(data.Keys)),
+		ContinueAt:     data.ContinueAt,
+		CommonPrefixes: data.CommonPrefixes,
 	}
 	for _, e := range data.Keys {
 		var r KeyStatusResponse
@@ -1288,11 +2143,83 @@ func (c *Client) ListKeys(ctx context.Context, req *ListRequest) (*Page[KeyStatu
 	return ls, nil
 }
 
+// NamespaceStatus returns status information about one or multiple key
+// namespaces within the enclave. A namespace is a key name prefix ending
+// with a '/', like "team/app/". Namespaces exist implicitly as long as
+// they contain keys or tags. Without any requests, NamespaceStatus
+// returns an empty slice and no error.
+//
+// It returns ErrEnclaveNotFound if no such enclave exists, wrapped in
+// a HostError.
+//
+// The returned error is of type *HostError.
+func (c *Client) NamespaceStatus(ctx context.Context, enclave string, reqs ...*NamespaceStatusRequest) ([]*NamespaceStatusResponse, error) {
+	if len(reqs) == 0 {
+		return []*NamespaceStatusResponse{}, nil
+	}
+
+	p := pool.Get(128 * len(reqs))
+	defer pool.Put(p)
+
+	body := (*p)[:0]
+	for _, req := range reqs {
+		var err error
+		body, err = cmds.Encode(body, cmds.KeyNamespaceStatus, req)
+		if err != nil {
+			return nil, hostError("", err)
+		}
+	}
+
+	resp, err := c.sendOp(ctx, "NamespaceStatus", &Request{
+		Enclave: enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[pb.NamespaceStatusResponse, NamespaceStatusResponse](resp, cmds.KeyNamespaceStatus)
+}
+
+// TagNamespace adds the tags req.Tags to and removes the tags
+// req.DeleteTags from the key namespace req.Namespace within
+// the enclave.
+//
+// Tags are metadata labels, like the owning team or cost center,
+// that apply to all keys within the namespace, including keys
+// within nested namespaces.
+//
+// It returns ErrEnclaveNotFound if no such enclave exists, wrapped
+// in a HostError.
+//
+// The returned error is of type *HostError.
+func (c *Client) TagNamespace(ctx context.Context, enclave string, req *TagNamespaceRequest) error {
+	p := pool.Get(128)
+	defer pool.Put(p)
+
+	body, err := cmds.Encode((*p)[:0], cmds.KeyNamespaceTag, req)
+	if err != nil {
+		return hostError("", err)
+	}
+
+	resp, err := c.sendOp(ctx, "TagNamespace", &Request{
+		Enclave: enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
 // Encrypt encrypts the req.Plaintext with the key req.Name within
 // the req.Enclave.
 //
 // It returns ErrEnclaveNotFound if no such enclave exists and
 // ErrKeyNotFound if no such key exists, wrapped in a HostError.
+// If the key version is decrypt-only or disabled, it returns
+// ErrKeyVersionDecryptOnly or ErrKeyVersionDisabled, respectively.
 //
 // The returned error is of type *HostError.
 func (c *Client) Encrypt(ctx context.Context, enclave string, reqs ...*EncryptRequest) ([]*EncryptResponse, error) {
@@ -1312,7 +2239,7 @@ func (c *Client) Encrypt(ctx context.Context, enclave string, reqs ...*EncryptRe
 		}
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "Encrypt", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1329,6 +2256,7 @@ func (c *Client) Encrypt(ctx context.Context, enclave string, reqs ...*EncryptRe
 //
 // It returns ErrEnclaveNotFound if no such enclave exists and
 // ErrKeyNotFound if no such key exists, wrapped in a HostError.
+// If the key version is disabled, it returns ErrKeyVersionDisabled.
 //
 // The returned error is of type *HostError.
 func (c *Client) Decrypt(ctx context.Context, enclave string, reqs ...*DecryptRequest) ([]*DecryptResponse, error) {
@@ -1348,7 +2276,7 @@ func (c *Client) Decrypt(ctx context.Context, enclave string, reqs ...*DecryptRe
 		}
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "Decrypt", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1379,6 +2307,8 @@ func (c *Client) Decrypt(ctx context.Context, enclave string, reqs ...*DecryptRe
 //
 // It returns ErrEnclaveNotFound if no such enclave exists and
 // ErrKeyNotFound if no such key exists, wrapped in a HostError.
+// If the key version is decrypt-only or disabled, it returns
+// ErrKeyVersionDecryptOnly or ErrKeyVersionDisabled, respectively.
 //
 // The returned error is of type *HostError.
 func (c *Client) GenerateKey(ctx context.Context, enclave string, reqs ...*GenerateKeyRequest) ([]*GenerateKeyResponse, error) {
@@ -1398,7 +2328,7 @@ func (c *Client) GenerateKey(ctx context.Context, enclave string, reqs ...*Gener
 		}
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "GenerateKey", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1416,6 +2346,8 @@ func (c *Client) GenerateKey(ctx context.Context, enclave string, reqs ...*Gener
 //
 // It returns ErrEnclaveNotFound if no such enclave exists and
 // ErrKeyNotFound if no such key exists, wrapped in a HostError.
+// If the key version is decrypt-only or disabled, it returns
+// ErrKeyVersionDecryptOnly or ErrKeyVersionDisabled, respectively.
 //
 // The returned error is of type *HostError.
 func (c *Client) MAC(ctx context.Context, enclave string, reqs ...*MACRequest) ([]*MACResponse, error) {
@@ -1439,7 +2371,7 @@ func (c *Client) MAC(ctx context.Context, enclave string, reqs ...*MACRequest) (
 		}
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "MAC", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1455,7 +2387,9 @@ func (c *Client) MAC(ctx context.Context, enclave string, reqs ...*MACRequest) (
 // name req.Name within the given enclave.
 //
 // It returns ErrEnclaveNotFound if no such enclave exists, wrapped in a
-// HostError. The returned error is of type *HostError.
+// HostError.
+//
+// The returned error is of type *HostError.
 func (c *Client) CreatePolicy(ctx context.Context, enclave string, req *CreatePolicyRequest) error {
 	p := pool.Get(128)
 	defer pool.Put(p)
@@ -1465,7 +2399,7 @@ func (c *Client) CreatePolicy(ctx context.Context, enclave string, req *CreatePo
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "CreatePolicy", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1480,7 +2414,12 @@ func (c *Client) CreatePolicy(ctx context.Context, enclave string, req *CreatePo
 //
 // It returns ErrEnclaveNotFound if no such enclave exists, ErrPolicyNotFound
 // if no such policy exists and ErrIdentityNotFound if no such identity exists,
-// wrapped in a HostError. The returned error is of type *HostError.
+// wrapped in a HostError.
+//
+// If req.DryRun is set, AssignPolicy only validates whether the policy
+// can be assigned and returns the error assigning it would fail with.
+//
+// The returned error is of type *HostError.
 func (c *Client) AssignPolicy(ctx context.Context, enclave string, req *AssignPolicyRequest) error {
 	p := pool.Get(128)
 	defer pool.Put(p)
@@ -1489,8 +2428,11 @@ func (c *Client) AssignPolicy(ctx context.Context, enclave string, req *AssignPo
 	if err != nil {
 		return err
 	}
+	if req.DryRun {
+		return c.dryRunOp(ctx, "AssignPolicy", &Request{Enclave: enclave, Body: body})
+	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "AssignPolicy", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1525,7 +2467,7 @@ func (c *Client) PolicyStatus(ctx context.Context, enclave string, reqs ...*Poli
 		}
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "PolicyStatus", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1562,7 +2504,7 @@ func (c *Client) GetPolicy(ctx context.Context, enclave string, reqs ...*PolicyR
 		}
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "GetPolicy", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1577,8 +2519,9 @@ func (c *Client) GetPolicy(ctx context.Context, enclave string, reqs ...*PolicyR
 // DeletePolicy deletes the policy with the name req.Name within the enclave.
 //
 // It returns ErrEnclaveNotFound if no such enclave exists and ErrPolicyNotFound
-// if such policy exists, wrapped in a HostError. The returned error is of type
-// *HostError.
+// if no such policy exists, wrapped in a HostError.
+//
+// The returned error is of type *HostError.
 func (c *Client) DeletePolicy(ctx context.Context, enclave string, req *DeletePolicyRequest) error {
 	p := pool.Get(128)
 	defer pool.Put(p)
@@ -1588,7 +2531,7 @@ func (c *Client) DeletePolicy(ctx context.Context, enclave string, req *DeletePo
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "DeletePolicy", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1612,7 +2555,7 @@ func (c *Client) ListPolicies(ctx context.Context, req *ListRequest) (*Page[Poli
 		return nil, err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "ListPolicies", &Request{
 		Enclave: req.Enclave,
 		Body:    body,
 	})
@@ -1644,7 +2587,9 @@ func (c *Client) ListPolicies(ctx context.Context, req *ListRequest) (*Page[Poli
 // name req.Identity within enclave.
 //
 // It returns ErrEnclaveNotFound if no such enclave exists, wrapped in a
-// HostError. The returned error is of type *HostError.
+// HostError.
+//
+// The returned error is of type *HostError.
 func (c *Client) CreateIdentity(ctx context.Context, enclave string, req *CreateIdentityRequest) error {
 	p := pool.Get(128)
 	defer pool.Put(p)
@@ -1654,7 +2599,7 @@ func (c *Client) CreateIdentity(ctx context.Context, enclave string, req *Create
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "CreateIdentity", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1689,7 +2634,7 @@ func (c *Client) GetIdentity(ctx context.Context, enclave string, reqs ...*Ident
 		}
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "GetIdentity", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1704,8 +2649,9 @@ func (c *Client) GetIdentity(ctx context.Context, enclave string, reqs ...*Ident
 // DeleteIdentity deletes the identity with the name req.Identity within the enclave.
 //
 // It returns ErrEnclaveNotFound if no such enclave exists and ErrIdentityNotFound
-// if such identity exists, wrapped in a HostError. The returned error is of type
-// *HostError.
+// if no such identity exists, wrapped in a HostError.
+//
+// The returned error is of type *HostError.
 func (c *Client) DeleteIdentity(ctx context.Context, enclave string, req *DeleteIdentityRequest) error {
 	p := pool.Get(128)
 	defer pool.Put(p)
@@ -1715,7 +2661,7 @@ func (c *Client) DeleteIdentity(ctx context.Context, enclave string, req *Delete
 		return err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "DeleteIdentity", &Request{
 		Enclave: enclave,
 		Body:    body,
 	})
@@ -1739,7 +2685,7 @@ func (c *Client) ListIdentities(ctx context.Context, req *ListRequest) (*Page[Id
 		return nil, err
 	}
 
-	resp, err := c.Send(ctx, &Request{
+	resp, err := c.sendOp(ctx, "ListIdentities", &Request{
 		Enclave: req.Enclave,
 		Body:    body,
 	})
@@ -1767,6 +2713,147 @@ func (c *Client) ListIdentities(ctx context.Context, req *ListRequest) (*Page[Id
 	return ls, nil
 }
 
+// CreateSecret creates a new secret with the name req.Name and the value
+// req.Value within the given enclave. By default, a new secret is created
+// if and only if no such secret exists. However, if req.AddVersion is set,
+// a new secret version is added to an existing secret.
+//
+// The secret value must not be empty and must not be larger than
+// MaxSecretSize.
+//
+// It returns ErrEnclaveNotFound if no such enclave exists and ErrSecretExists
+// if such a secret already exists, wrapped in a HostError.
+//
+// The returned error is of type *HostError.
+func (c *Client) CreateSecret(ctx context.Context, enclave string, req *CreateSecretRequest) error {
+	p := pool.Get(128 + len(req.Value))
+	defer pool.Put(p)
+
+	body, err := cmds.Encode((*p)[:0], cmds.SecretCreate, req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.sendOp(ctx, "CreateSecret", &Request{
+		Enclave: enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// GetSecret returns one or multiple secret versions, including their
+// values, within the enclave. Without any requests, GetSecret returns
+// an empty slice and no error.
+//
+// The returned error is of type *HostError. If the enclave does not
+// exist, GetSecret returns ErrEnclaveNotFound wrapped in a HostError.
+// Similarly, if at least one secret or secret version does not exist,
+// GetSecret returns ErrSecretNotFound wrapped in a HostError.
+func (c *Client) GetSecret(ctx context.Context, enclave string, reqs ...*SecretRequest) ([]*SecretResponse, error) {
+	if len(reqs) == 0 {
+		return []*SecretResponse{}, nil
+	}
+
+	p := pool.Get(128 * len(reqs))
+	defer pool.Put(p)
+
+	body := (*p)[:0]
+	for _, req := range reqs {
+		var err error
+		body, err = cmds.Encode(body, cmds.SecretGet, req)
+		if err != nil {
+			return nil, hostError("", err)
+		}
+	}
+
+	resp, err := c.sendOp(ctx, "GetSecret", &Request{
+		Enclave: enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[pb.SecretResponse, SecretResponse](resp, cmds.SecretGet)
+}
+
+// ListSecrets returns a page of secrets within the enclave req.Enclave.
+// The page contains the latest version of each secret but no secret
+// values. All secret names start with the req.Prefix and the first
+// secret name matches req.ContinueAt. The page contains at most
+// req.Limit secrets.
+//
+// ListSecrets implements paginated listing. For iterating over a stream
+// of secrets combine it with an Iter.
+//
+// The returned error is of type *HostError.
+func (c *Client) ListSecrets(ctx context.Context, req *ListRequest) (*Page[SecretStatusResponse], error) {
+	body, err := cmds.Encode(nil, cmds.SecretList, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendOp(ctx, "ListSecrets", &Request{
+		Enclave: req.Enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var data pb.ListSecretsResponse
+	if err := decodeResponseMessage(resp, cmds.SecretList, &data); err != nil {
+		return nil, err
+	}
+
+	ls := &Page[SecretStatusResponse]{
+		Items:      make([]SecretStatusResponse, 0, len(data.Secrets)),
+		ContinueAt: data.ContinueAt,
+	}
+	for _, s := range data.Secrets {
+		var r SecretStatusResponse
+		if err = r.UnmarshalPB(s); err != nil {
+			return nil, hostError(resp.Request.URL.Host, err)
+		}
+		ls.Items = append(ls.Items, r)
+	}
+	return ls, nil
+}
+
+// DeleteSecret deletes the secret version req.Version of the secret with
+// the name req.Name within the enclave. It deletes the latest secret
+// version if no version is specified and the entire secret and all
+// versions if req.AllVersions is set.
+//
+// It returns ErrEnclaveNotFound if no such enclave exists and
+// ErrSecretNotFound if no such secret or secret version exists,
+// wrapped in a HostError.
+//
+// The returned error is of type *HostError.
+func (c *Client) DeleteSecret(ctx context.Context, enclave string, req *DeleteSecretRequest) error {
+	p := pool.Get(128)
+	defer pool.Put(p)
+
+	body, err := cmds.Encode((*p)[:0], cmds.SecretDelete, req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.sendOp(ctx, "DeleteSecret", &Request{
+		Enclave: enclave,
+		Body:    body,
+	})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
 // httpsURL turns the endpoint into an HTTPS endpoint.
 func httpsURL(endpoint string) string {
 	endpoint = strings.TrimPrefix(endpoint, "http://")
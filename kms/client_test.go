@@ -0,0 +1,789 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/internal/headers"
+	pb "github.com/openstor/kms-go/kms/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClient_ListKeys_Delimiter(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"key", "team/app/key-1", "team/app/prod/key-2", "team/db", "team/web/key"}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var req pb.ListRequest
+		if _, err := cmds.DecodePB(body, cmds.KeyList, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var resp pb.ListKeysResponse
+		for _, name := range keys {
+			if !strings.HasPrefix(name, req.Prefix) {
+				continue
+			}
+			if i := strings.Index(name[len(req.Prefix):], req.Delimiter); req.Delimiter != "" && i >= 0 {
+				prefix := name[:len(req.Prefix)+i+1]
+				if !slices.Contains(resp.CommonPrefixes, prefix) {
+					resp.CommonPrefixes = append(resp.CommonPrefixes, prefix)
+				}
+				continue
+			}
+			resp.Keys = append(resp.Keys, &pb.KeyStatusResponse{Name: name, Version: 1, Type: AES256.String()})
+		}
+		b, _ := cmds.EncodePB(nil, cmds.KeyList, &resp)
+		w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+		w.Write(b)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server, []string{server.Listener.Addr().String()})
+	for i, test := range listKeysDelimiterTests {
+		page, err := client.ListKeys(context.Background(), &ListRequest{
+			Enclave:   "my-enclave",
+			Prefix:    test.Prefix,
+			Delimiter: test.Delimiter,
+		})
+		if err != nil {
+			t.Fatalf("Test %d: failed to list keys: %v", i, err)
+		}
+
+		names := make([]string, 0, len(page.Items))
+		for _, item := range page.Items {
+			names = append(names, item.Name)
+		}
+		if !slices.Equal(names, test.Keys) {
+			t.Fatalf("Test %d: keys mismatch: got '%v' - want '%v'", i, names, test.Keys)
+		}
+		if !slices.Equal(page.CommonPrefixes, test.CommonPrefixes) {
+			t.Fatalf("Test %d: common prefixes mismatch: got '%v' - want '%v'", i, page.CommonPrefixes, test.CommonPrefixes)
+		}
+	}
+
+	if _, err := client.ListKeys(context.Background(), &ListRequest{Delimiter: "//"}); err == nil {
+		t.Fatal("Listed keys with multi-character delimiter")
+	}
+}
+
+var listKeysDelimiterTests = []struct {
+	Prefix         string
+	Delimiter      string
+	Keys           []string
+	CommonPrefixes []string
+}{
+	{Keys: []string{"key", "team/app/key-1", "team/app/prod/key-2", "team/db", "team/web/key"}},
+	{Delimiter: "/", Keys: []string{"key"}, CommonPrefixes: []string{"team/"}},
+	{Prefix: "team/", Delimiter: "/", Keys: []string{"team/db"}, CommonPrefixes: []string{"team/app/", "team/web/"}},
+	{Prefix: "team/app/", Delimiter: "/", Keys: []string{"team/app/key-1"}, CommonPrefixes: []string{"team/app/prod/"}},
+}
+
+func TestClient_NamespaceStatus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var resp []byte
+		for len(body) > 0 {
+			var req pb.NamespaceStatusRequest
+			var err error
+			if body, err = cmds.DecodePB(body, cmds.KeyNamespaceStatus, &req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			resp, _ = cmds.EncodePB(resp, cmds.KeyNamespaceStatus, &pb.NamespaceStatusResponse{
+				Namespace: req.Namespace,
+				Keys:      uint64(strings.Count(req.Namespace, "/")),
+				Tags:      map[string]string{"owner": "team"},
+			})
+		}
+		w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+		w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server, []string{server.Listener.Addr().String()})
+	resps, err := client.NamespaceStatus(context.Background(), "my-enclave",
+		&NamespaceStatusRequest{Namespace: "team"},
+		&NamespaceStatusRequest{Namespace: "team/app/"},
+	)
+	if err != nil {
+		t.Fatalf("Failed to fetch namespace status: %v", err)
+	}
+	if len(resps) != 2 {
+		t.Fatalf("Response count mismatch: got %d - want %d", len(resps), 2)
+	}
+	if resps[0].Namespace != "team/" || resps[0].Keys != 1 {
+		t.Fatalf("Namespace mismatch: got '%s' with %d keys - want '%s' with %d keys", resps[0].Namespace, resps[0].Keys, "team/", 1)
+	}
+	if resps[1].Namespace != "team/app/" || resps[1].Tags["owner"] != "team" {
+		t.Fatalf("Namespace mismatch: got '%s' with tags %v", resps[1].Namespace, resps[1].Tags)
+	}
+
+	if _, err = client.NamespaceStatus(context.Background(), "my-enclave", &NamespaceStatusRequest{}); err == nil {
+		t.Fatal("Fetched status of empty namespace")
+	}
+}
+
+func TestClient_QueryLogs(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC)
+	records := []*pb.LogRecord{
+		{Level: int32(slog.LevelInfo), Message: "server started", Time: pb.Time(start.Add(-time.Hour))},
+		{Level: int32(slog.LevelError), Message: "disk: write failed", Time: pb.Time(start)},
+		{Level: int32(slog.LevelWarn), Message: "disk: slow write", Time: pb.Time(start.Add(10 * time.Minute))},
+		{Level: int32(slog.LevelError), Message: "disk: write failed", Time: pb.Time(start.Add(20 * time.Minute))},
+		{Level: int32(slog.LevelError), Message: "network: timeout", Time: pb.Time(start.Add(30 * time.Minute))},
+		{Level: int32(slog.LevelError), Message: "disk: write failed", Time: pb.Time(start.Add(time.Hour))},
+	}
+	for i, rec := range records {
+		rec.Cursor = strconv.Itoa(i)
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var req pb.LogRequest
+		if err := proto.Unmarshal(body, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Until.AsTime().IsZero() {
+			http.Error(w, "log query without end", http.StatusBadRequest)
+			return
+		}
+
+		indices := make([]int, 0, len(records))
+		for i := range records {
+			indices = append(indices, i)
+		}
+		if req.Descending {
+			slices.Reverse(indices)
+		}
+		if req.ContinueAt != "" {
+			n, _ := strconv.Atoi(req.ContinueAt)
+			indices = indices[slices.Index(indices, n)+1:]
+		}
+
+		w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+		var n uint32
+		for _, i := range indices {
+			rec := records[i]
+			if rec.Level < req.Level || !strings.Contains(rec.Message, req.Message) {
+				continue
+			}
+			if rec.Time.AsTime().Before(req.Since.AsTime()) || !rec.Time.AsTime().Before(req.Until.AsTime()) {
+				continue
+			}
+			if req.Limit > 0 && n == req.Limit {
+				break
+			}
+			n++
+
+			b, _ := proto.Marshal(rec)
+			w.Write(binary.BigEndian.AppendUint32(nil, uint32(len(b))))
+			w.Write(b)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server, []string{server.Listener.Addr().String()})
+	for i, test := range queryLogsTests {
+		req := &LogRequest{
+			Level:      test.Level,
+			Message:    test.Message,
+			Since:      start,
+			Until:      start.Add(time.Hour),
+			Limit:      test.Limit,
+			Descending: test.Descending,
+		}
+
+		var cursors []string
+		for {
+			var page int
+			for rec, err := range client.QueryLogs(context.Background(), req) {
+				if err != nil {
+					t.Fatalf("Test %d: failed to query logs: %v", i, err)
+				}
+				cursors = append(cursors, rec.Cursor)
+				req.ContinueAt = rec.Cursor
+				page++
+			}
+			if test.Limit == 0 || page < test.Limit {
+				break
+			}
+		}
+		if !slices.Equal(cursors, test.Cursors) {
+			t.Fatalf("Test %d: records mismatch: got '%v' - want '%v'", i, cursors, test.Cursors)
+		}
+	}
+
+	if _, err := client.Logs(context.Background(), &LogRequest{Since: start, Until: start.Add(-time.Hour)}); err == nil {
+		t.Fatal("Fetched logs with until before since")
+	}
+}
+
+var queryLogsTests = []struct {
+	Level      slog.Level
+	Message    string
+	Limit      int
+	Descending bool
+	Cursors    []string
+}{
+	{Cursors: []string{"1", "2", "3", "4"}},
+	{Level: slog.LevelError, Cursors: []string{"1", "3", "4"}},
+	{Level: slog.LevelError, Message: "disk:", Cursors: []string{"1", "3"}},
+	{Level: slog.LevelError, Descending: true, Cursors: []string{"4", "3", "1"}},
+	{Limit: 1, Cursors: []string{"1", "2", "3", "4"}},
+	{Limit: 3, Descending: true, Cursors: []string{"4", "3", "2", "1"}},
+}
+
+func TestClient_DryRun_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	var executed []cmds.Command
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		// The server executes all commands it knows and
+		// ignores any dry-run header. It does not support
+		// the DRYRUN command.
+		cmd, _ := cmds.Inspect(body)
+		switch cmd {
+		case cmds.KeyDelete:
+			executed = append(executed, cmd)
+			w.Header().Set(headers.KMSDryRun, "true")
+			w.WriteHeader(http.StatusOK)
+		case cmds.DryRun:
+			if strings.HasSuffix(r.URL.Path, "/ignore-cmds") {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			b, _ := proto.Marshal(&pb.ErrResponse{Message: "unknown command"})
+			w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+			w.WriteHeader(http.StatusNotImplemented)
+			w.Write(b)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server, []string{server.Listener.Addr().String()})
+	err := client.DeleteKey(context.Background(), "my-enclave", &DeleteKeyRequest{Name: "my-key", DryRun: true})
+	if err == nil {
+		t.Fatal("Dry-run should have been rejected")
+	}
+	var apiErr Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotImplemented {
+		t.Fatalf("Error mismatch: got '%v' - want status %d", err, http.StatusNotImplemented)
+	}
+	if len(executed) > 0 {
+		t.Fatalf("Server executed commands during dry-run: %v", executed)
+	}
+
+	// A server that accepts the request without confirming
+	// the dry-run is not trusted.
+	body, _ := cmds.Encode(nil, cmds.KeyDelete, &DeleteKeyRequest{Name: "my-key"})
+	if _, err = client.DryRun(context.Background(), &Request{Enclave: "ignore-cmds", Body: body}); !errors.Is(err, ErrDryRunNotSupported) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, ErrDryRunNotSupported)
+	}
+}
+
+func TestClient_DryRun_Mismatch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu        sync.Mutex
+		responses []cmds.Command // Commands of the next dry-run responses
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		var body []byte
+		for _, cmd := range responses {
+			body, _ = cmds.Encode(body, cmd, &DryRunResponse{})
+		}
+		w.Header().Set(headers.KMSDryRun, "true")
+		w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	body, _ := cmds.Encode(nil, cmds.KeyDelete, &DeleteKeyRequest{Name: "my-key"})
+	body, _ = cmds.Encode(body, cmds.KeyCreate, &CreateKeyRequest{Name: "my-key"})
+
+	client := newTestClient(t, server, []string{server.Listener.Addr().String()})
+	for i, test := range []struct {
+		Responses  []cmds.Command
+		ShouldFail bool
+	}{
+		{Responses: []cmds.Command{cmds.KeyDelete, cmds.KeyCreate}},                                   // 0
+		{Responses: []cmds.Command{cmds.KeyCreate, cmds.KeyDelete}, ShouldFail: true},                 // 1
+		{Responses: []cmds.Command{cmds.KeyDelete, cmds.KeyDelete}, ShouldFail: true},                 // 2
+		{Responses: []cmds.Command{cmds.KeyDelete}, ShouldFail: true},                                 // 3
+		{Responses: []cmds.Command{cmds.KeyDelete, cmds.KeyCreate, cmds.KeyCreate}, ShouldFail: true}, // 4
+		{Responses: []cmds.Command{}, ShouldFail: true},                                               // 5
+	} {
+		mu.Lock()
+		responses = test.Responses
+		mu.Unlock()
+
+		results, err := client.DryRun(context.Background(), &Request{Enclave: "my-enclave", Body: body})
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: dry-run response for commands %v has been accepted", i, test.Responses)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: dry-run failed: %v", i, err)
+		}
+		if err == nil && len(results) != 2 {
+			t.Fatalf("Test %d: result count mismatch: got %d - want %d", i, len(results), 2)
+		}
+	}
+}
+
+func TestClient_UpdateNodeAddr(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu     sync.Mutex
+		addrs  []string            // Node addresses by node ID
+		edits  map[string][]string // New addresses by edited host
+		failAt string              // Host that fails to edit its cluster definition
+	)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		switch cmd, _ := cmds.Inspect(body); cmd {
+		case cmds.ClusterStatus:
+			status := &pb.ClusterStatusResponse{NodesUp: map[uint32]*pb.ServerStatusResponse{}}
+			for id, addr := range addrs {
+				status.NodesUp[uint32(id)] = &pb.ServerStatusResponse{Host: addr, ID: uint32(id)}
+			}
+			b, _ := cmds.EncodePB(nil, cmds.ClusterStatus, status)
+			w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+			w.Write(b)
+		case cmds.ClusterEdit:
+			var req pb.EditClusterRequest
+			if _, err := cmds.DecodePB(body, cmds.ClusterEdit, &req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if r.Host == failAt {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			for _, addr := range req.UpdateAddrs {
+				edits[r.Host] = append(edits[r.Host], addr)
+			}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	servers := make([]*httptest.Server, 3)
+	for i := range servers {
+		servers[i] = httptest.NewTLSServer(handler)
+		defer servers[i].Close()
+		addrs = append(addrs, servers[i].Listener.Addr().String())
+	}
+	client := newTestClient(t, servers[0], slices.Clone(addrs))
+
+	for i, test := range []struct {
+		ID     int
+		Addr   string
+		FailAt int      // Index of the server that fails or -1
+		Edited []string // Hosts that should have been edited
+		Err    error
+	}{
+		{ // 0
+			ID:     1,
+			Addr:   " https://10.0.0.9:7373/",
+			FailAt: -1,
+			Edited: []string{addrs[1], addrs[0], addrs[2]}, // The node itself is edited first
+		},
+		{ // 1
+			ID:     5,
+			Addr:   "10.0.0.9:7373",
+			FailAt: -1,
+			Err:    ErrNodeNotFound,
+		},
+		{ // 2
+			ID:     1,
+			Addr:   addrs[2],
+			FailAt: -1,
+			Err:    errors.New("kms: address is used by node '2'"),
+		},
+		{ // 3
+			ID:     1,
+			Addr:   "10.0.0.9:7373",
+			FailAt: 0,
+			Edited: []string{addrs[1]},
+			Err:    &ClusterEditError{Edited: []string{addrs[1]}, Host: addrs[0]},
+		},
+	} {
+		mu.Lock()
+		edits, failAt = map[string][]string{}, ""
+		if test.FailAt >= 0 {
+			failAt = addrs[test.FailAt]
+		}
+		mu.Unlock()
+
+		err := client.UpdateNodeAddr(context.Background(), test.ID, test.Addr)
+		switch want := test.Err.(type) {
+		case nil:
+			if err != nil {
+				t.Fatalf("Test %d: failed to update node address: %v", i, err)
+			}
+		case *ClusterEditError:
+			var editErr *ClusterEditError
+			if !errors.As(err, &editErr) {
+				t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, want)
+			}
+			if editErr.Host != want.Host || !slices.Equal(editErr.Edited, want.Edited) {
+				t.Fatalf("Test %d: error mismatch: got '%v' - want edited '%v' and failed '%s'", i, err, want.Edited, want.Host)
+			}
+		case Error:
+			if !errors.Is(err, want) {
+				t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, want)
+			}
+		default:
+			if err == nil || !strings.Contains(err.Error(), want.Error()) {
+				t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, want)
+			}
+		}
+
+		mu.Lock()
+		for _, host := range test.Edited {
+			if update := edits[host]; len(update) != 1 || update[0] != "10.0.0.9:7373" {
+				t.Fatalf("Test %d: host '%s' has not been updated: %v", i, host, update)
+			}
+		}
+		if len(edits) != len(test.Edited) {
+			t.Fatalf("Test %d: edited hosts mismatch: got %d - want %d", i, len(edits), len(test.Edited))
+		}
+		mu.Unlock()
+	}
+
+	// The client's host list is not changed.
+	if hosts := client.Hosts(); !slices.Equal(hosts, addrs) {
+		t.Fatalf("Client hosts mismatch: got '%v' - want '%v'", hosts, addrs)
+	}
+}
+
+func TestClient_ExportEnclave(t *testing.T) {
+	t.Parallel()
+
+	// The fake archive is the enclave name followed by the export key.
+	var (
+		mu       sync.Mutex
+		enclaves = map[string]bool{"my-enclave": true}
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		writeError := func(err Error) {
+			w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+			w.WriteHeader(err.Code)
+			b, _ := proto.Marshal(&pb.ErrResponse{Message: err.Err})
+			w.Write(b)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		switch cmd, _ := cmds.Inspect(body); cmd {
+		case cmds.EnclaveExport:
+			var req ExportEnclaveRequest
+			if _, err := cmds.Decode(body, cmds.EnclaveExport, &req); err != nil {
+				writeError(Error{Code: http.StatusBadRequest, Err: err.Error()})
+				return
+			}
+			if !enclaves[req.Name] {
+				writeError(ErrEnclaveNotFound)
+				return
+			}
+			resp, _ := cmds.Encode(nil, cmds.EnclaveExport, &ExportEnclaveResponse{
+				Name:    req.Name,
+				Archive: append([]byte(req.Name+"\x00"), req.ExportKey...),
+			})
+			w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+			w.Write(resp)
+		case cmds.EnclaveImport:
+			var req ImportEnclaveRequest
+			if _, err := cmds.Decode(body, cmds.EnclaveImport, &req); err != nil {
+				writeError(Error{Code: http.StatusBadRequest, Err: err.Error()})
+				return
+			}
+			name, key, ok := strings.Cut(string(req.Archive), "\x00")
+			if !ok || key != string(req.ExportKey) {
+				writeError(ErrDecrypt)
+				return
+			}
+			if req.Name != "" {
+				name = req.Name
+			}
+			if enclaves[name] {
+				writeError(ErrEnclaveExists)
+				return
+			}
+			enclaves[name] = true
+		default:
+			writeError(Error{Code: http.StatusNotImplemented, Err: "unsupported command"})
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := newTestClient(t, server, []string{server.Listener.Addr().String()})
+
+	exportKey := make([]byte, 32)
+	for i := range exportKey {
+		exportKey[i] = byte(i)
+	}
+	resp, err := client.ExportEnclave(ctx, &ExportEnclaveRequest{Name: "my-enclave", ExportKey: exportKey})
+	if err != nil {
+		t.Fatalf("Failed to export enclave: %v", err)
+	}
+	if resp.Name != "my-enclave" || len(resp.Archive) == 0 {
+		t.Fatalf("Response mismatch: got '%s' with %d bytes archive", resp.Name, len(resp.Archive))
+	}
+
+	for i, test := range []struct {
+		Request ImportEnclaveRequest
+		Err     error
+	}{
+		{Request: ImportEnclaveRequest{Archive: resp.Archive, ExportKey: exportKey}, Err: ErrEnclaveExists},                      // 0
+		{Request: ImportEnclaveRequest{Name: "new-enclave", Archive: resp.Archive, ExportKey: exportKey}},                        // 1
+		{Request: ImportEnclaveRequest{Name: "new-enclave", Archive: resp.Archive, ExportKey: exportKey}, Err: ErrEnclaveExists}, // 2
+		{Request: ImportEnclaveRequest{Name: "other-enclave", Archive: resp.Archive}, Err: ErrDecrypt},                           // 3
+	} {
+		err := client.ImportEnclave(ctx, &test.Request)
+		if !errors.Is(err, test.Err) {
+			t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, test.Err)
+		}
+		if err != nil && AsHostError(err) == nil {
+			t.Fatalf("Test %d: error is not a HostError: %v", i, err)
+		}
+	}
+
+	if _, err = client.ExportEnclave(ctx, &ExportEnclaveRequest{Name: "other-enclave"}); !errors.Is(err, ErrEnclaveNotFound) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, ErrEnclaveNotFound)
+	}
+	if _, err = client.ExportEnclave(ctx, &ExportEnclaveRequest{Name: "my-enclave", ExportKey: exportKey[:16]}); err == nil {
+		t.Fatal("Exported enclave with 128 bit export key")
+	}
+	if err = client.ImportEnclave(ctx, &ImportEnclaveRequest{Name: "other-enclave"}); err == nil {
+		t.Fatal("Imported enclave from empty archive")
+	}
+}
+
+func TestClient_TransferLeadership(t *testing.T) {
+	t.Parallel()
+
+	const ElectionTimeout = 20 * time.Millisecond
+	var (
+		mu        sync.Mutex
+		leader    int
+		accept    bool // Whether the leader hands off its leadership
+		transfers int
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		switch cmd, _ := cmds.Inspect(body); cmd {
+		case cmds.ClusterStatus:
+			status := &pb.ClusterStatusResponse{
+				NodesUp:   map[uint32]*pb.ServerStatusResponse{},
+				NodesDown: map[uint32]string{3: "10.0.0.4:7373"},
+			}
+			for id, role := range []NodeRole{NodeVoter, NodeVoter, NodeLearner} {
+				status.NodesUp[uint32(id)] = &pb.ServerStatusResponse{
+					Host:              "10.0.0." + strconv.Itoa(id+1) + ":7373",
+					ID:                uint32(id),
+					Role:              "Follower",
+					NodeRole:          role.String(),
+					LeaderID:          int64(leader),
+					HeartbeatInterval: pb.Duration(ElectionTimeout / 4),
+					ElectionTimeout:   pb.Duration(ElectionTimeout),
+				}
+			}
+			status.NodesUp[uint32(leader)].Role = "Leader"
+
+			b, _ := cmds.EncodePB(nil, cmds.ClusterStatus, status)
+			w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+			w.Write(b)
+		case cmds.ClusterTransferLeader:
+			var req TransferLeadershipRequest
+			if _, err := cmds.Decode(body, cmds.ClusterTransferLeader, &req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			transfers++
+			if accept {
+				leader = req.NodeID
+			}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server, []string{server.Listener.Addr().String()})
+	for i, test := range []struct {
+		NodeID    int
+		Accept    bool
+		Timeout   time.Duration // Context timeout, if > 0
+		Transfers int
+		Err       error
+	}{
+		{NodeID: 1, Accept: true, Transfers: 1}, // 0
+		{NodeID: 1, Accept: true},               // 1 - Already the leader
+		{NodeID: 2, Err: errors.New("kms: node is a learner and cannot become leader")},            // 2
+		{NodeID: 3, Err: errors.New("kms: node is down")},                                          // 3
+		{NodeID: 5, Err: ErrNodeNotFound},                                                          // 4
+		{NodeID: 0, Transfers: 1, Err: errors.New("kms: node has not become leader within 200ms")}, // 5
+		{NodeID: 0, Timeout: 5 * ElectionTimeout, Transfers: 1, Err: context.DeadlineExceeded},     // 6
+	} {
+		mu.Lock()
+		accept, transfers = test.Accept, 0
+		mu.Unlock()
+
+		ctx, cancel := context.Background(), context.CancelFunc(func() {})
+		if test.Timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, test.Timeout)
+		}
+		err := client.TransferLeadership(ctx, test.NodeID)
+		cancel()
+
+		switch want := test.Err.(type) {
+		case nil:
+			if err != nil {
+				t.Fatalf("Test %d: failed to transfer leadership: %v", i, err)
+			}
+		case Error:
+			if !errors.Is(err, want) {
+				t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, want)
+			}
+		default:
+			if !errors.Is(err, want) && (err == nil || !strings.HasSuffix(err.Error(), want.Error())) {
+				t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, want)
+			}
+		}
+
+		mu.Lock()
+		if transfers != test.Transfers {
+			t.Fatalf("Test %d: transfer mismatch: got %d - want %d", i, transfers, test.Transfers)
+		}
+		mu.Unlock()
+	}
+}
+
+func TestClient_Learners(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu         sync.Mutex
+		learnerErr Error // Error the learner responds with, if any
+		requests   map[string]int
+	)
+	handler := func(name string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			requests[name]++
+			if name == "learner" && learnerErr.Code != 0 {
+				w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+				w.WriteHeader(learnerErr.Code)
+				b, _ := proto.Marshal(&pb.ErrResponse{Message: learnerErr.Err})
+				w.Write(b)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+	voter := httptest.NewTLSServer(handler("voter"))
+	defer voter.Close()
+	learner := httptest.NewTLSServer(handler("learner"))
+	defer learner.Close()
+
+	newClient := func(learner string) *Client {
+		conf := newTestConfig(t, voter, []string{voter.Listener.Addr().String()})
+		conf.Learners = []string{learner}
+		client, err := NewClient(conf)
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		return client
+	}
+	client := newClient(learner.Listener.Addr().String())
+	unreachable := newClient(closedAddr(t))
+
+	for i, test := range []struct {
+		Client     *Client
+		LearnerErr Error
+		Cmd        cmds.Command
+		Requests   map[string]int
+		Err        error
+	}{
+		{Client: client, Cmd: cmds.KeyStatus, Requests: map[string]int{"learner": 1}},                                                                                      // 0
+		{Client: client, Cmd: cmds.KeyCreate, Requests: map[string]int{"voter": 1}},                                                                                        // 1
+		{Client: client, LearnerErr: ErrKeyNotFound, Cmd: cmds.KeyStatus, Requests: map[string]int{"learner": 1, "voter": 1}},                                              // 2
+		{Client: client, LearnerErr: ErrEnclaveNotFound, Cmd: cmds.KeyStatus, Requests: map[string]int{"learner": 1, "voter": 1}},                                          // 3
+		{Client: client, LearnerErr: Error{http.StatusServiceUnavailable, "node is catching up"}, Cmd: cmds.KeyStatus, Requests: map[string]int{"learner": 1, "voter": 1}}, // 4
+		{Client: client, LearnerErr: ErrPermission, Cmd: cmds.KeyStatus, Requests: map[string]int{"learner": 1}, Err: ErrPermission},                                       // 5
+		{Client: unreachable, Cmd: cmds.KeyStatus, Requests: map[string]int{"voter": 1}},                                                                                   // 6
+	} {
+		mu.Lock()
+		learnerErr, requests = test.LearnerErr, map[string]int{}
+		mu.Unlock()
+
+		body, err := cmds.Encode(nil, test.Cmd, &KeyStatusRequest{Name: "my-key"})
+		if err != nil {
+			t.Fatalf("Test %d: failed to encode request: %v", i, err)
+		}
+		resp, err := test.Client.Send(context.Background(), &Request{Enclave: "my-enclave", Body: body})
+		if err == nil {
+			resp.Body.Close()
+		}
+		if !errors.Is(err, test.Err) {
+			t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, test.Err)
+		}
+
+		mu.Lock()
+		got := requests
+		mu.Unlock()
+		if !maps.Equal(got, test.Requests) {
+			t.Fatalf("Test %d: requests mismatch: got '%v' - want '%v'", i, got, test.Requests)
+		}
+	}
+}
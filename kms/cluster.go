@@ -6,9 +6,17 @@ package kms
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"maps"
+	"net/http"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/openstor/kms-go/kms/cmds"
+	pb "github.com/openstor/kms-go/kms/protobuf"
 )
 
 // Join joins the servers specified in conf.Endpoints
@@ -82,3 +90,312 @@ func Join(ctx context.Context, conf *Config) error {
 	}
 	return nil
 }
+
+// ParseNodeRole returns a NodeRole from its string representation.
+func ParseNodeRole(s string) (NodeRole, error) {
+	switch s {
+	case "Voter":
+		return NodeVoter, nil
+	case "Learner":
+		return NodeLearner, nil
+	default:
+		return 0, fmt.Errorf("kms: node role '%s' is not supported", s)
+	}
+}
+
+// NodeRole defines the role of a KMS server within a cluster.
+type NodeRole uint
+
+// Supported node roles.
+const (
+	// NodeVoter represents a KMS server that takes part in
+	// leader elections and write quorums. Nodes join a cluster
+	// as voters by default.
+	NodeVoter NodeRole = iota + 1
+
+	// NodeLearner represents a KMS server that replicates the
+	// cluster state but neither takes part in leader elections
+	// nor in write quorums. Learners act as read replicas. For
+	// example, in remote regions, without affecting the write
+	// latency of the cluster.
+	NodeLearner
+)
+
+// String returns the string representation of the NodeRole.
+func (r NodeRole) String() string {
+	switch r {
+	case NodeVoter:
+		return "Voter"
+	case NodeLearner:
+		return "Learner"
+	default:
+		return "!INVALID:" + strconv.Itoa(int(r))
+	}
+}
+
+// parseNodeRole parses s as NodeRole. Servers that don't
+// support learners don't send any role. All nodes are
+// voters implicitly.
+func parseNodeRole(s string) (NodeRole, error) {
+	if s == "" {
+		return NodeVoter, nil
+	}
+	return ParseNodeRole(s)
+}
+
+// BootstrapOptions contains options for bootstrapping
+// a KMS cluster. Refer to Client.Bootstrap.
+type BootstrapOptions struct {
+	// Role is the role of the added KMS servers within
+	// the cluster. If not set, servers join as voters.
+	Role NodeRole
+
+	// PollInterval is the frequency in which the cluster
+	// status is checked while waiting for an added server
+	// to catch up. If <= 0, it defaults to 1 second.
+	PollInterval time.Duration
+
+	// CatchUpTimeout limits how long to wait for each
+	// added server to catch up with the cluster leader.
+	// If <= 0, it defaults to 1 minute.
+	CatchUpTimeout time.Duration
+}
+
+// Bootstrap forms a cluster by adding the KMS servers at hosts, one
+// at a time, to the cluster of the seed server. Hosts that are already
+// part of the seed's cluster are skipped. Hence, a failed Bootstrap
+// can be retried.
+//
+// Before changing the cluster membership, Bootstrap verifies that all
+// servers run the same version, agree on FIPS 140 mode and that every
+// host is empty, i.e. neither part of a multi-node cluster nor contains
+// any enclaves.
+//
+// Each server joins the cluster as learner. Bootstrap waits until it
+// has caught up with the leader's commit index and, unless opts.Role
+// is NodeLearner, promotes it to a voter before adding the next one.
+// If adding a server fails, Bootstrap removes all servers it has added
+// from the cluster again and returns an error that includes any errors
+// encountered during the roll back.
+//
+// Bootstrap does not change the client's list of hosts. It requires
+// SysAdmin privileges.
+func (c *Client) Bootstrap(ctx context.Context, seed string, hosts []string, opts *BootstrapOptions) error {
+	const Scheme = "https://"
+
+	if opts == nil {
+		opts = &BootstrapOptions{}
+	}
+	seed = strings.TrimPrefix(seed, Scheme)
+
+	all := []string{seed}
+	for _, host := range hosts {
+		host = strings.TrimPrefix(host, Scheme)
+		if !slices.Contains(all, host) {
+			all = append(all, host)
+		}
+	}
+	if len(all) == 1 {
+		return nil
+	}
+
+	versions, err := c.Version(ctx, &VersionRequest{Hosts: all})
+	if err != nil {
+		return err
+	}
+	for i, v := range versions[1:] {
+		if v.Version != versions[0].Version || v.APIVersion != versions[0].APIVersion {
+			return fmt.Errorf("kms: server '%s' runs version '%s' but seed '%s' runs '%s'", all[i+1], v.Version, seed, versions[0].Version)
+		}
+		if v.FIPS140 != versions[0].FIPS140 {
+			return fmt.Errorf("kms: server '%s' has FIPS 140 mode '%v' but seed '%s' has '%v'", all[i+1], v.FIPS140, seed, versions[0].FIPS140)
+		}
+	}
+
+	status, err := c.clusterStatus(ctx, seed)
+	if err != nil {
+		return err
+	}
+	members := make(map[string]bool, len(status.NodesUp)+len(status.NodesDown))
+	for _, node := range status.NodesUp {
+		members[node.Host] = true
+	}
+	for _, addr := range status.NodesDown {
+		members[addr] = true
+	}
+
+	var join []string
+	for _, host := range all[1:] {
+		if members[host] {
+			continue
+		}
+		if err = c.verifyEmpty(ctx, host); err != nil {
+			return err
+		}
+		join = append(join, host)
+	}
+
+	// A server may have joined even if adding it failed, for example
+	// due to a network error. Hence, it is rolled back as well.
+	added := make([]string, 0, len(join))
+	for _, host := range join {
+		added = append(added, host)
+		if err = c.bootstrapNode(ctx, seed, host, opts); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	for _, host := range slices.Backward(added) {
+		resp, rmErr := sendCmd(c, context.WithoutCancel(ctx), "RemoveNode", seed, cmds.ClusterRemoveNode, &RemoveClusterNodeRequest{
+			Host:                host,
+			DeleteClusterOnHost: true,
+		})
+		if rmErr == nil {
+			resp.Body.Close()
+		} else if !errors.Is(rmErr, ErrNodeNotFound) {
+			errs = append(errs, fmt.Errorf("kms: failed to remove '%s' while rolling back: %w", host, rmErr))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// bootstrapNode adds the server at host to the seed's cluster,
+// waits until it has caught up and promotes it, if necessary.
+func (c *Client) bootstrapNode(ctx context.Context, seed, host string, opts *BootstrapOptions) error {
+	pollInterval, timeout := opts.PollInterval, opts.CatchUpTimeout
+	if pollInterval <= 0 {
+		pollInterval = 1 * time.Second
+	}
+	if timeout <= 0 {
+		timeout = 1 * time.Minute
+	}
+
+	resp, err := sendCmd(c, ctx, "AddNode", seed, cmds.ClusterAddNode, &AddClusterNodeRequest{
+		Host: host,
+		Role: NodeLearner,
+	})
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	errCatchUp := fmt.Errorf("kms: server '%s' did not catch up with the cluster within %v", host, timeout)
+	ctx, cancel := context.WithTimeoutCause(ctx, timeout, errCatchUp)
+	defer cancel()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var (
+		commit    uint64 // The leader's commit index after adding host
+		hasCommit bool
+	)
+	for {
+		status, err := c.clusterStatus(ctx, seed)
+		if err != nil {
+			// The timeout may expire while fetching the status,
+			// for example, when the ticker and the timeout fire
+			// at the same time.
+			if context.Cause(ctx) == errCatchUp {
+				return errCatchUp
+			}
+			return err
+		}
+		if leader, ok := status.NodesUp[leaderID(status)]; ok && !hasCommit {
+			commit, hasCommit = leader.Commit, true
+		}
+		for _, node := range status.NodesUp {
+			if node.Host == host && hasCommit && node.Commit >= commit {
+				if opts.Role == NodeLearner {
+					return nil
+				}
+				resp, err = sendCmd(c, ctx, "PromoteNode", seed, cmds.ClusterPromoteNode, &PromoteClusterNodeRequest{
+					Host: host,
+				})
+				if err != nil {
+					return err
+				}
+				return resp.Body.Close()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if context.Cause(ctx) == errCatchUp {
+				return errCatchUp
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// verifyEmpty returns an error if the server at host is part
+// of a multi-node cluster or contains any enclave.
+func (c *Client) verifyEmpty(ctx context.Context, host string) error {
+	status, err := c.clusterStatus(ctx, host)
+	if err != nil {
+		return err
+	}
+	if len(status.NodesUp)+len(status.NodesDown) > 1 {
+		return fmt.Errorf("kms: server '%s' is already part of a cluster", host)
+	}
+
+	resp, err := sendCmd(c, ctx, "ListEnclaves", host, cmds.EnclaveList, &ListRequest{Limit: 1})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var data pb.ListEnclavesResponse
+	if err = decodeResponseMessage(resp, cmds.EnclaveList, &data); err != nil {
+		return err
+	}
+	if len(data.Enclaves) > 0 {
+		return fmt.Errorf("kms: server '%s' is not empty: it contains enclaves", host)
+	}
+	return nil
+}
+
+// clusterStatus fetches the cluster status from the server at host.
+func (c *Client) clusterStatus(ctx context.Context, host string) (*ClusterStatusResponse, error) {
+	resp, err := sendCmd(c, ctx, "ClusterStatus", host, cmds.ClusterStatus, &ClusterStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := decodeResponse[pb.ClusterStatusResponse, ClusterStatusResponse](resp, cmds.ClusterStatus)
+	if err != nil {
+		return nil, err
+	}
+	return data[0], nil
+}
+
+// sendCmd sends the command cmd to the server at host on behalf
+// of the Client method op. If the returned error is nil, the
+// caller has to close the response body.
+func sendCmd[M any, P pb.Pointer[M], T pb.Marshaler[P]](c *Client, ctx context.Context, op, host string, cmd cmds.Command, req T) (*http.Response, error) {
+	body, err := cmds.Encode[M, P, T](nil, cmd, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendOp(ctx, op, &Request{
+		Host: host,
+		Body: body,
+	})
+}
+
+// leaderID returns the ID of the cluster leader or -1.
+func leaderID(status *ClusterStatusResponse) int {
+	for _, node := range status.NodesUp {
+		if node.LeaderID >= 0 {
+			return node.LeaderID
+		}
+	}
+	return -1
+}
@@ -11,28 +11,41 @@ import (
 )
 
 const (
-	ClusterAddNode    Command = 1
-	ClusterRemoveNode Command = 2
-	ClusterStatus     Command = 3
-	ClusterEdit       Command = 4
-	ClusterAddHSM     Command = 5
-	ClusterRemoveHSM  Command = 6
+	ClusterAddNode        Command = 1
+	ClusterRemoveNode     Command = 2
+	ClusterStatus         Command = 3
+	ClusterEdit           Command = 4
+	ClusterAddHSM         Command = 5
+	ClusterRemoveHSM      Command = 6
+	ClusterPromoteNode    Command = 7
+	ClusterDemoteNode     Command = 8
+	ClusterTransferLeader Command = 9
 
 	EnclaveCreate Command = 101
 	EnclaveDelete Command = 102
 	EnclaveStatus Command = 103
 	EnclaveList   Command = 104
-
-	KeyCreate       Command = 201
-	KeyDelete       Command = 202
-	KeyImport       Command = 203
-	KeyStatus       Command = 204
-	KeyEncrypt      Command = 205
-	KeyDecrypt      Command = 206
-	KeyGenerate     Command = 207
-	KeyList         Command = 208
-	KeyListVersions Command = 209
-	KeyMAC          Command = 210
+	EnclaveExport Command = 105
+	EnclaveImport Command = 106
+	EnclaveRename Command = 107
+
+	KeyCreate          Command = 201
+	KeyDelete          Command = 202
+	KeyImport          Command = 203
+	KeyStatus          Command = 204
+	KeyEncrypt         Command = 205
+	KeyDecrypt         Command = 206
+	KeyGenerate        Command = 207
+	KeyList            Command = 208
+	KeyListVersions    Command = 209
+	KeyMAC             Command = 210
+	KeyExport          Command = 211
+	KeyImportWrap      Command = 212
+	KeyWrappingKey     Command = 213
+	KeySetState        Command = 214
+	KeyNamespaceStatus Command = 215
+	KeyNamespaceTag    Command = 216
+	KeyMove            Command = 217
 
 	PolicyCreate Command = 301
 	PolicyDelete Command = 302
@@ -45,6 +58,13 @@ const (
 	IdentityDelete Command = 402
 	IdentityGet    Command = 403
 	IdentityList   Command = 404
+
+	SecretCreate Command = 501
+	SecretGet    Command = 502
+	SecretList   Command = 503
+	SecretDelete Command = 504
+
+	DryRun Command = 901
 )
 
 // Parse parses s as a string representation of a Command.
@@ -114,18 +134,27 @@ func (c *Command) UnmarshalText(text []byte) error {
 }
 
 var isWrite = map[Command]struct{}{ // Commands that change state on a KMS server
-	ClusterAddNode:    {},
-	ClusterRemoveNode: {},
-	ClusterEdit:       {},
-	ClusterAddHSM:     {},
-	ClusterRemoveHSM:  {},
+	ClusterAddNode:        {},
+	ClusterRemoveNode:     {},
+	ClusterEdit:           {},
+	ClusterAddHSM:         {},
+	ClusterRemoveHSM:      {},
+	ClusterPromoteNode:    {},
+	ClusterDemoteNode:     {},
+	ClusterTransferLeader: {},
 
 	EnclaveCreate: {},
 	EnclaveDelete: {},
+	EnclaveImport: {},
+	EnclaveRename: {},
 
-	KeyCreate: {},
-	KeyDelete: {},
-	KeyImport: {},
+	KeyCreate:       {},
+	KeyDelete:       {},
+	KeyImport:       {},
+	KeyImportWrap:   {},
+	KeySetState:     {},
+	KeyNamespaceTag: {},
+	KeyMove:         {},
 
 	PolicyCreate: {},
 	PolicyDelete: {},
@@ -133,44 +162,67 @@ var isWrite = map[Command]struct{}{ // Commands that change state on a KMS serve
 
 	IdentityCreate: {},
 	IdentityDelete: {},
+
+	SecretCreate: {},
+	SecretDelete: {},
 }
 
 var isCluster = map[Command]struct{}{ // Commands that operate on a cluster-level and require sysadmin privileges
-	ClusterAddNode:    {},
-	ClusterRemoveNode: {},
-	ClusterStatus:     {},
-	ClusterEdit:       {},
-	ClusterAddHSM:     {},
-	ClusterRemoveHSM:  {},
+	ClusterAddNode:        {},
+	ClusterRemoveNode:     {},
+	ClusterStatus:         {},
+	ClusterEdit:           {},
+	ClusterAddHSM:         {},
+	ClusterRemoveHSM:      {},
+	ClusterPromoteNode:    {},
+	ClusterDemoteNode:     {},
+	ClusterTransferLeader: {},
 
 	EnclaveCreate: {},
 	EnclaveDelete: {},
 	EnclaveStatus: {},
 	EnclaveList:   {},
+	EnclaveExport: {},
+	EnclaveImport: {},
+	EnclaveRename: {},
 }
 
 var cmdTexts = map[Command]string{
-	ClusterAddNode:    "CLUSTER:ADDNODE",
-	ClusterRemoveNode: "CLUSTER:REMOVENODE",
-	ClusterStatus:     "CLUSTER:STATUS",
-	ClusterEdit:       "CLUSTER:EDIT",
-	ClusterAddHSM:     "CLUSTER:ADDHSM",
-	ClusterRemoveHSM:  "CLUSTER:REMOVEHSM",
+	ClusterAddNode:        "CLUSTER:ADDNODE",
+	ClusterRemoveNode:     "CLUSTER:REMOVENODE",
+	ClusterStatus:         "CLUSTER:STATUS",
+	ClusterEdit:           "CLUSTER:EDIT",
+	ClusterAddHSM:         "CLUSTER:ADDHSM",
+	ClusterRemoveHSM:      "CLUSTER:REMOVEHSM",
+	ClusterPromoteNode:    "CLUSTER:PROMOTENODE",
+	ClusterDemoteNode:     "CLUSTER:DEMOTENODE",
+	ClusterTransferLeader: "CLUSTER:TRANSFERLEADER",
 
 	EnclaveCreate: "ENCLAVE:CREATE",
 	EnclaveDelete: "ENCLAVE:DELETE",
 	EnclaveStatus: "ENCLAVE:STATUS",
 	EnclaveList:   "ENCLAVE:LIST",
-
-	KeyCreate:       "KEY:CREATE",
-	KeyDelete:       "KEY:DELETE",
-	KeyStatus:       "KEY:STATUS",
-	KeyEncrypt:      "KEY:ENCRYPT",
-	KeyDecrypt:      "KEY:DECRYPT",
-	KeyGenerate:     "KEY:GENERATE",
-	KeyList:         "KEY:LIST",
-	KeyListVersions: "KEY:LISTVERSIONS",
-	KeyMAC:          "KEY:MAC",
+	EnclaveExport: "ENCLAVE:EXPORT",
+	EnclaveImport: "ENCLAVE:IMPORT",
+	EnclaveRename: "ENCLAVE:RENAME",
+
+	KeyCreate:          "KEY:CREATE",
+	KeyDelete:          "KEY:DELETE",
+	KeyImport:          "KEY:IMPORT",
+	KeyStatus:          "KEY:STATUS",
+	KeyEncrypt:         "KEY:ENCRYPT",
+	KeyDecrypt:         "KEY:DECRYPT",
+	KeyGenerate:        "KEY:GENERATE",
+	KeyList:            "KEY:LIST",
+	KeyListVersions:    "KEY:LISTVERSIONS",
+	KeyMAC:             "KEY:MAC",
+	KeyExport:          "KEY:EXPORT",
+	KeyImportWrap:      "KEY:IMPORTWRAP",
+	KeyWrappingKey:     "KEY:WRAPPINGKEY",
+	KeySetState:        "KEY:SETSTATE",
+	KeyNamespaceStatus: "KEY:NAMESPACESTATUS",
+	KeyNamespaceTag:    "KEY:NAMESPACETAG",
+	KeyMove:            "KEY:MOVE",
 
 	PolicyCreate: "POLICY:CREATE",
 	PolicyDelete: "POLICY:DELETE",
@@ -183,30 +235,51 @@ var cmdTexts = map[Command]string{
 	IdentityDelete: "IDENTITY:DELETE",
 	IdentityGet:    "IDENTITY:STATUS",
 	IdentityList:   "IDENTITY:LIST",
+
+	SecretCreate: "SECRET:CREATE",
+	SecretGet:    "SECRET:GET",
+	SecretList:   "SECRET:LIST",
+	SecretDelete: "SECRET:DELETE",
+
+	DryRun: "DRYRUN",
 }
 
 var textCmds = map[string]Command{
-	"CLUSTER:ADDNODE":    ClusterAddNode,
-	"CLUSTER:REMOVENODE": ClusterRemoveNode,
-	"CLUSTER:STATUS":     ClusterStatus,
-	"CLUSTER:EDIT":       ClusterEdit,
-	"CLUSTER:ADDHSM":     ClusterAddHSM,
-	"CLUSTER:REMOVEHSM":  ClusterRemoveHSM,
+	"CLUSTER:ADDNODE":        ClusterAddNode,
+	"CLUSTER:REMOVENODE":     ClusterRemoveNode,
+	"CLUSTER:STATUS":         ClusterStatus,
+	"CLUSTER:EDIT":           ClusterEdit,
+	"CLUSTER:ADDHSM":         ClusterAddHSM,
+	"CLUSTER:REMOVEHSM":      ClusterRemoveHSM,
+	"CLUSTER:PROMOTENODE":    ClusterPromoteNode,
+	"CLUSTER:DEMOTENODE":     ClusterDemoteNode,
+	"CLUSTER:TRANSFERLEADER": ClusterTransferLeader,
 
 	"ENCLAVE:CREATE": EnclaveCreate,
 	"ENCLAVE:DELETE": EnclaveDelete,
 	"ENCLAVE:STATUS": EnclaveStatus,
 	"ENCLAVE:LIST":   EnclaveList,
-
-	"KEY:CREATE":       KeyCreate,
-	"KEY:DELETE":       KeyDelete,
-	"KEY:STATUS":       KeyStatus,
-	"KEY:ENCRYPT":      KeyEncrypt,
-	"KEY:DECRYPT":      KeyDecrypt,
-	"KEY:GENERATE":     KeyGenerate,
-	"KEY:LIST":         KeyList,
-	"KEY:LISTVERSIONS": KeyListVersions,
-	"KEY:MAC":          KeyMAC,
+	"ENCLAVE:EXPORT": EnclaveExport,
+	"ENCLAVE:IMPORT": EnclaveImport,
+	"ENCLAVE:RENAME": EnclaveRename,
+
+	"KEY:CREATE":          KeyCreate,
+	"KEY:DELETE":          KeyDelete,
+	"KEY:IMPORT":          KeyImport,
+	"KEY:STATUS":          KeyStatus,
+	"KEY:ENCRYPT":         KeyEncrypt,
+	"KEY:DECRYPT":         KeyDecrypt,
+	"KEY:GENERATE":        KeyGenerate,
+	"KEY:LIST":            KeyList,
+	"KEY:LISTVERSIONS":    KeyListVersions,
+	"KEY:MAC":             KeyMAC,
+	"KEY:EXPORT":          KeyExport,
+	"KEY:IMPORTWRAP":      KeyImportWrap,
+	"KEY:WRAPPINGKEY":     KeyWrappingKey,
+	"KEY:SETSTATE":        KeySetState,
+	"KEY:NAMESPACESTATUS": KeyNamespaceStatus,
+	"KEY:NAMESPACETAG":    KeyNamespaceTag,
+	"KEY:MOVE":            KeyMove,
 
 	"POLICY:CREATE": PolicyCreate,
 	"POLICY:DELETE": PolicyDelete,
@@ -219,4 +292,11 @@ var textCmds = map[string]Command{
 	"IDENTITY:DELETE": IdentityDelete,
 	"IDENTITY:STATUS": IdentityGet,
 	"IDENTITY:LIST":   IdentityList,
+
+	"SECRET:CREATE": SecretCreate,
+	"SECRET:GET":    SecretGet,
+	"SECRET:LIST":   SecretList,
+	"SECRET:DELETE": SecretDelete,
+
+	"DRYRUN": DryRun,
 }
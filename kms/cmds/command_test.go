@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package cmds
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCommand_MarshalText(t *testing.T) {
+	t.Parallel()
+
+	for cmd, text := range cmdTexts {
+		if got := cmd.String(); got != text {
+			t.Fatalf("Command %d: text mismatch: got '%s' - want '%s'", cmd, got, text)
+		}
+		if c, ok := textCmds[text]; !ok || c != cmd {
+			t.Fatalf("Command %d: text '%s' does not map back to the command", cmd, text)
+		}
+
+		var c Command
+		if err := c.UnmarshalText([]byte(strings.ToLower(text))); err != nil {
+			t.Fatalf("Command %d: failed to unmarshal '%s': %v", cmd, text, err)
+		}
+		if c != cmd {
+			t.Fatalf("Command %d: command mismatch: got '%d' - want '%d'", cmd, c, cmd)
+		}
+	}
+	for text, cmd := range textCmds {
+		if _, ok := cmdTexts[cmd]; !ok {
+			t.Fatalf("Command '%s': no text representation for command %d", text, cmd)
+		}
+	}
+
+	for _, cmd := range []Command{KeyImport, KeyExport, KeyImportWrap, KeyWrappingKey} {
+		text, err := cmd.MarshalText()
+		if err != nil {
+			t.Fatalf("Command %d: failed to marshal: %v", cmd, err)
+		}
+		if c, err := Parse(string(text)); err != nil || c != cmd {
+			t.Fatalf("Command %d: failed to parse '%s': %v", cmd, text, err)
+		}
+	}
+	if _, err := Command(0).MarshalText(); err == nil {
+		t.Fatal("Marshaling invalid command succeeded")
+	}
+}
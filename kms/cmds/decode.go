@@ -90,3 +90,59 @@ func DecodePB(b []byte, cmd Command, v proto.Message) ([]byte, error) {
 	}
 	return b[6+int(n):], nil
 }
+
+// ReadOnly reports whether b contains only commands that
+// neither change state on a KMS server nor operate on a
+// cluster-level. It returns false if b is empty or not
+// a valid sequence of encoded commands.
+//
+// Read-only commands can be served by any KMS server
+// that replicates the cluster state, including learners.
+func ReadOnly(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+	for len(b) > 0 {
+		if len(b) < 6 {
+			return false
+		}
+
+		c := Command(binary.BigEndian.Uint16(b))
+		if _, ok := cmdTexts[c]; !ok || c.IsWrite() || c.IsCluster() {
+			return false
+		}
+
+		n := binary.BigEndian.Uint32(b[2:])
+		if uint64(len(b)-6) < uint64(n) {
+			return false
+		}
+		b = b[6+int(n):]
+	}
+	return true
+}
+
+// Inspect returns the first command in b and the total number of
+// commands b contains. It returns 0, 0 if b is empty or not a valid
+// sequence of encoded commands.
+func Inspect(b []byte) (Command, int) {
+	var (
+		cmd Command
+		n   int
+	)
+	for len(b) > 0 {
+		if len(b) < 6 {
+			return 0, 0
+		}
+		if n == 0 {
+			cmd = Command(binary.BigEndian.Uint16(b))
+		}
+
+		size := binary.BigEndian.Uint32(b[2:])
+		if uint64(len(b)-6) < uint64(size) {
+			return 0, 0
+		}
+		b = b[6+int(size):]
+		n++
+	}
+	return cmd, n
+}
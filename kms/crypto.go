@@ -5,6 +5,9 @@
 package kms
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
 	"fmt"
 	"strconv"
 )
@@ -46,3 +49,85 @@ func (s SecretKeyType) String() string {
 		return "!INVALID:" + strconv.Itoa(int(s))
 	}
 }
+
+// ParseKeyState returns a KeyState from its string representation.
+func ParseKeyState(s string) (KeyState, error) {
+	switch s {
+	case "Enabled":
+		return KeyEnabled, nil
+	case "DecryptOnly":
+		return KeyDecryptOnly, nil
+	case "Disabled":
+		return KeyDisabled, nil
+	default:
+		return 0, fmt.Errorf("kms: key state '%s' is not supported", s)
+	}
+}
+
+// KeyState defines the state of a key version. It controls which
+// cryptographic operations a key version can be used for.
+type KeyState uint
+
+// Supported key version states.
+const (
+	// KeyEnabled represents a key version that can be used for
+	// any cryptographic operation. Key versions are enabled by
+	// default.
+	KeyEnabled KeyState = iota + 1
+
+	// KeyDecryptOnly represents a key version that can only be
+	// used to decrypt existing ciphertexts. Encrypting, generating
+	// data keys and computing MACs is refused.
+	KeyDecryptOnly
+
+	// KeyDisabled represents a key version that cannot be used
+	// for any cryptographic operation. In contrast to deleting
+	// a key version, disabling it can be reverted.
+	KeyDisabled
+)
+
+// String returns the string representation of the KeyState.
+func (s KeyState) String() string {
+	switch s {
+	case KeyEnabled:
+		return "Enabled"
+	case KeyDecryptOnly:
+		return "DecryptOnly"
+	case KeyDisabled:
+		return "Disabled"
+	default:
+		return "!INVALID:" + strconv.Itoa(int(s))
+	}
+}
+
+// KCVSize is the size of a key check value in bytes.
+const KCVSize = 8
+
+// KCV returns the key check value (KCV) of the given key material.
+//
+// A KCV identifies a key without revealing it. Two keys are identical
+// if and only if their KCVs match, with overwhelming probability. Hence,
+// operators can compare keys across clusters, backups or HSMs without
+// exporting key material.
+//
+// The KCV is the HMAC-SHA256 of an all-zero block, truncated to KCVSize
+// bytes, under a key derived from the key material. The KCV key is
+// separate from any key used for encryption or authentication.
+func KCV(key []byte) []byte {
+	const Context = "kms: key check value"
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(Context))
+	kcvKey := mac.Sum(nil)
+
+	var block [sha256.BlockSize]byte
+	mac = hmac.New(sha256.New, kcvKey)
+	mac.Write(block[:])
+	return mac.Sum(nil)[:KCVSize]
+}
+
+// VerifyKCV reports whether kcv is the key check value
+// of the given key material. Refer to KCV.
+func VerifyKCV(key, kcv []byte) bool {
+	return len(kcv) == KCVSize && subtle.ConstantTimeCompare(KCV(key), kcv) == 1
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestKCV(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range kcvTests {
+		key, kcv := mustDecodeHex(test.Key), mustDecodeHex(test.KCV)
+		if got := KCV(key); !bytes.Equal(got, kcv) {
+			t.Fatalf("Test %d: KCV mismatch: got '%x' - want '%x'", i, got, kcv)
+		}
+		if !VerifyKCV(key, kcv) {
+			t.Fatalf("Test %d: failed to verify KCV", i)
+		}
+
+		kcv[0] ^= 1
+		if VerifyKCV(key, kcv) {
+			t.Fatalf("Test %d: verified modified KCV", i)
+		}
+		if VerifyKCV(key, kcv[:KCVSize-1]) {
+			t.Fatalf("Test %d: verified truncated KCV", i)
+		}
+	}
+}
+
+var kcvTests = []struct {
+	Key string
+	KCV string
+}{
+	{ // 0
+		Key: "0000000000000000000000000000000000000000000000000000000000000000",
+		KCV: "8a18d0c3b6a91a91",
+	},
+	{ // 1
+		Key: "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		KCV: "1427bacf726a03d7",
+	},
+	{ // 2
+		Key: "603deb1015ca71be2b73aef0857d77811f352c073b6108d72d9810a30914dff4",
+		KCV: "c242f72cc7fce2f5",
+	},
+}
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms_test
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/kmstest"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server, node := kmstest.NewServer(nil), kmstest.NewServer(nil)
+	defer server.Close()
+	defer node.Close()
+
+	client := kmstest.NewClient(t, server)
+	if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "my-enclave"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	if err := client.CreateKey(ctx, "my-enclave", &kms.CreateKeyRequest{Name: "my-key"}); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	if err := client.CreatePolicy(ctx, "my-enclave", &kms.CreatePolicyRequest{
+		Name:  "my-policy",
+		Allow: map[cmds.Command]kms.RuleSet{cmds.KeyStatus: {"my-*": {}}},
+	}); err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+	key, err := mtls.GenerateKeyEdDSA(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate API key: %v", err)
+	}
+	if err = client.CreateIdentity(ctx, "my-enclave", &kms.CreateIdentityRequest{Identity: key.Identity(), Privilege: kms.User}); err != nil {
+		t.Fatalf("Failed to create identity: %v", err)
+	}
+	if err = client.AddNode(ctx, &kms.AddClusterNodeRequest{Host: node.Addr}); err != nil {
+		t.Fatalf("Failed to add node: %v", err)
+	}
+	user := kmstest.NewClientWithKey(t, key, server)
+
+	for i, test := range []struct {
+		DryRun func() error
+		Err    error
+	}{
+		{ // 0
+			DryRun: func() error {
+				return client.DeleteKey(ctx, "my-enclave", &kms.DeleteKeyRequest{Name: "my-key", DryRun: true})
+			},
+		},
+		{ // 1
+			DryRun: func() error {
+				return client.DeleteKey(ctx, "my-enclave", &kms.DeleteKeyRequest{Name: "other-key", DryRun: true})
+			},
+			Err: kms.ErrKeyNotFound,
+		},
+		{ // 2
+			DryRun: func() error {
+				return user.DeleteKey(ctx, "my-enclave", &kms.DeleteKeyRequest{Name: "my-key", DryRun: true})
+			},
+			Err: kms.ErrPermission,
+		},
+		{ // 3
+			DryRun: func() error {
+				return client.DeleteEnclave(ctx, &kms.DeleteEnclaveRequest{Name: "my-enclave", DryRun: true})
+			},
+		},
+		{ // 4
+			DryRun: func() error {
+				return client.DeleteEnclave(ctx, &kms.DeleteEnclaveRequest{Name: "other-enclave", DryRun: true})
+			},
+			Err: kms.ErrEnclaveNotFound,
+		},
+		{ // 5
+			DryRun: func() error {
+				return client.AssignPolicy(ctx, "my-enclave", &kms.AssignPolicyRequest{Policy: "my-policy", Identity: key.Identity(), DryRun: true})
+			},
+		},
+		{ // 6
+			DryRun: func() error {
+				return client.AssignPolicy(ctx, "my-enclave", &kms.AssignPolicyRequest{Policy: "other-policy", Identity: key.Identity(), DryRun: true})
+			},
+			Err: kms.ErrPolicyNotFound,
+		},
+		{ // 7
+			DryRun: func() error {
+				return client.RemoveNode(ctx, &kms.RemoveClusterNodeRequest{Host: node.Addr, DryRun: true})
+			},
+		},
+		{ // 8
+			DryRun: func() error {
+				return client.RemoveNode(ctx, &kms.RemoveClusterNodeRequest{Host: "127.0.0.1:1", DryRun: true})
+			},
+			Err: kms.ErrNodeNotFound,
+		},
+		{ // 9
+			DryRun: func() error {
+				return user.RemoveNode(ctx, &kms.RemoveClusterNodeRequest{Host: node.Addr, DryRun: true})
+			},
+			Err: kms.ErrPermission,
+		},
+	} {
+		if err := test.DryRun(); !errors.Is(err, test.Err) {
+			t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, test.Err)
+		}
+	}
+
+	// Nothing has been changed by any dry-run.
+	if _, err = client.KeyStatus(ctx, "my-enclave", &kms.KeyStatusRequest{Name: "my-key"}); err != nil {
+		t.Fatalf("Failed to fetch key status: %v", err)
+	}
+	if _, err = user.KeyStatus(ctx, "my-enclave", &kms.KeyStatusRequest{Name: "my-key"}); !errors.Is(err, kms.ErrPermission) {
+		t.Fatalf("Policy has been assigned by dry-run: %v", err)
+	}
+	if nodes := server.Nodes(); len(nodes) != 2 {
+		t.Fatalf("Node has been removed by dry-run: %v", nodes)
+	}
+
+	if err = client.DeleteKey(ctx, "my-enclave", &kms.DeleteKeyRequest{Name: "my-key"}); err != nil {
+		t.Fatalf("Failed to delete key: %v", err)
+	}
+	if _, err = client.KeyStatus(ctx, "my-enclave", &kms.KeyStatusRequest{Name: "my-key"}); !errors.Is(err, kms.ErrKeyNotFound) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, kms.ErrKeyNotFound)
+	}
+}
+
+func TestClient_DryRun_Batch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := kmstest.NewServer(nil)
+	defer server.Close()
+
+	client := kmstest.NewClient(t, server)
+	if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "my-enclave"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	if err := client.CreateKey(ctx, "my-enclave", &kms.CreateKeyRequest{Name: "key-1"}); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+
+	var (
+		body []byte
+		err  error
+	)
+	for _, name := range []string{"key-1", "key-2"} {
+		if body, err = cmds.Encode(body, cmds.KeyDelete, &kms.DeleteKeyRequest{Name: name}); err != nil {
+			t.Fatalf("Failed to encode request: %v", err)
+		}
+	}
+	if body, err = cmds.Encode(body, cmds.KeyCreate, &kms.CreateKeyRequest{Name: "key-1"}); err != nil {
+		t.Fatalf("Failed to encode request: %v", err)
+	}
+
+	results, err := client.DryRun(ctx, &kms.Request{Enclave: "my-enclave", Body: body})
+	if err != nil {
+		t.Fatalf("Failed to dry-run batch: %v", err)
+	}
+	want := []error{nil, kms.ErrKeyNotFound, kms.ErrKeyExists}
+	if len(results) != len(want) {
+		t.Fatalf("Result mismatch: got %d results - want %d", len(results), len(want))
+	}
+	for i, result := range results {
+		if !errors.Is(result.Err, want[i]) {
+			t.Fatalf("Result %d: error mismatch: got '%v' - want '%v'", i, result.Err, want[i])
+		}
+	}
+
+	if _, err = client.KeyStatus(ctx, "my-enclave", &kms.KeyStatusRequest{Name: "key-1"}); err != nil {
+		t.Fatalf("Key has been deleted by dry-run: %v", err)
+	}
+}
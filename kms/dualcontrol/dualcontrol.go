@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package dualcontrol implements data encryption keys that can
+// only be decrypted with the cooperation of two KMS clusters.
+//
+// A DEK is split into two shares using a 2-of-2 XOR split. Each
+// share is encrypted by a different KMS cluster and both encrypted
+// shares are stored together in one Envelope.
+// Neither share reveals anything about the DEK. Hence, the
+// administrators of one cluster alone cannot decrypt it:
+//
+//	dc := &dualcontrol.DualControl{
+//		First:  dualcontrol.Side{Client: clusterA, Enclave: "vault", Key: "split-key"},
+//		Second: dualcontrol.Side{Client: clusterB, Enclave: "vault", Key: "split-key"},
+//	}
+//	dek, envelope, err := dc.GenerateKey(ctx, []byte("object-name"))
+package dualcontrol
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/openstor/kms-go/kms"
+)
+
+// KeySize is the size of DEKs generated by DualControl in bytes.
+const KeySize = 32
+
+// Side is one of the two KMS clusters that have to cooperate
+// to decrypt a DEK.
+type Side struct {
+	// Name is an optional name of the side, like the team
+	// administering the KMS cluster. It is used in errors.
+	// If empty, defaults to "first" or "second".
+	Name string
+
+	// Client is the KMS client of the side's cluster.
+	Client *kms.Client
+
+	// Enclave is the enclave containing the Key.
+	Enclave string
+
+	// Key is the name of the KMS key that encrypts
+	// the side's share.
+	Key string
+
+	// Previous is an optional list of keys, besides Key,
+	// the side accepts when decrypting shares. For example,
+	// the keys it has used before switching to Key. Shares
+	// encrypted with any other key are rejected.
+	Previous []KeyRef
+}
+
+// KeyRef identifies a KMS key within an enclave.
+type KeyRef struct {
+	Enclave string
+	Key     string
+}
+
+// DualControl generates and decrypts DEKs that require the
+// cooperation of two KMS clusters. Both sides must use
+// different KMS clients.
+type DualControl struct {
+	First  Side
+	Second Side
+}
+
+// ErrSameClient is returned by DualControl if both sides use
+// the same KMS client. The administrators of a single cluster
+// could decrypt both shares.
+var ErrSameClient = errors.New("dualcontrol: both sides use the same KMS client")
+
+// ErrKeyNotAllowed is returned, wrapped in a *SideError, if an
+// Envelope refers to a key the side is not configured for.
+var ErrKeyNotAllowed = errors.New("dualcontrol: share is not encrypted with a key of the side")
+
+// Envelope contains the two encrypted shares of a DEK.
+// It can be stored as JSON next to the encrypted data.
+type Envelope struct {
+	First  Share `json:"first"`
+	Second Share `json:"second"`
+}
+
+// Share is a DEK share encrypted by one side.
+type Share struct {
+	Enclave    string `json:"enclave"`
+	Key        string `json:"key"`
+	Version    int    `json:"version"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// SideError is returned when one side fails to encrypt or
+// decrypt its share. For example, because its administrators
+// have revoked access to the key.
+type SideError struct {
+	Side    string // Name of the side
+	Op      string // "encrypt" or "decrypt"
+	Enclave string
+	Key     string
+	Err     error
+}
+
+// Error returns the error message, naming the side that failed.
+func (e *SideError) Error() string {
+	return fmt.Sprintf("dualcontrol: %s side failed to %s share with key '%s' in enclave '%s': %v", e.Side, e.Op, e.Key, e.Enclave, e.Err)
+}
+
+// Unwrap returns the underlying KMS error.
+func (e *SideError) Unwrap() error { return e.Err }
+
+// GenerateKey generates a new DEK, splits it into two shares
+// and encrypts each share with one side. It returns the
+// plaintext DEK and the Envelope containing the encrypted
+// shares.
+//
+// The associated data is bound to both shares. The same
+// associated data must be provided when decrypting the DEK.
+//
+// If a side fails, GenerateKey returns a *SideError. If
+// both sides fail, the returned error contains both. It
+// returns ErrSameClient if both sides use the same client.
+func (d *DualControl) GenerateKey(ctx context.Context, associatedData []byte) ([]byte, *Envelope, error) {
+	if d.First.Client == d.Second.Client {
+		return nil, nil, ErrSameClient
+	}
+
+	dek := make([]byte, KeySize)
+	first := make([]byte, KeySize)
+	second := make([]byte, KeySize)
+	defer clear(first)
+	defer clear(second)
+
+	rand.Read(dek)
+	rand.Read(first)
+	subtle.XORBytes(second, dek, first)
+
+	var (
+		env       = new(Envelope)
+		errs      [2]error
+		sides     = [2]*Side{&d.First, &d.Second}
+		shares    = [2][]byte{first, second}
+		encShares = [2]*Share{&env.First, &env.Second}
+		wg        sync.WaitGroup
+	)
+	for i := range sides {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			*encShares[i], errs[i] = sides[i].encrypt(ctx, i, shares[i], associatedData)
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs[:]...); err != nil {
+		clear(dek)
+		return nil, nil, err
+	}
+	return dek, env, nil
+}
+
+// Decrypt decrypts both shares of the Envelope concurrently
+// and returns the DEK.
+//
+// Each share must be encrypted with the side's Key or one of
+// its Previous keys. Otherwise, Decrypt returns a *SideError
+// wrapping ErrKeyNotAllowed. Hence, an Envelope cannot make a
+// side decrypt a share with an arbitrary key.
+//
+// If a side refuses to decrypt its share, Decrypt returns a
+// *SideError naming the side. If both sides fail, the returned
+// error contains both. Use errors.As to inspect them. Like
+// GenerateKey, Decrypt returns ErrSameClient if both sides use
+// the same client.
+func (d *DualControl) Decrypt(ctx context.Context, env *Envelope, associatedData []byte) ([]byte, error) {
+	if d.First.Client == d.Second.Client {
+		return nil, ErrSameClient
+	}
+
+	var (
+		errs   [2]error
+		shares [2][]byte
+		sides  = [2]*Side{&d.First, &d.Second}
+		enc    = [2]*Share{&env.First, &env.Second}
+		wg     sync.WaitGroup
+	)
+	for i := range sides {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			shares[i], errs[i] = sides[i].decrypt(ctx, i, enc[i], associatedData)
+		}()
+	}
+	wg.Wait()
+
+	defer clear(shares[0])
+	defer clear(shares[1])
+	if err := errors.Join(errs[:]...); err != nil {
+		return nil, err
+	}
+	if len(shares[0]) != KeySize || len(shares[1]) != KeySize {
+		return nil, errors.New("dualcontrol: invalid share length")
+	}
+
+	dek := make([]byte, KeySize)
+	subtle.XORBytes(dek, shares[0], shares[1])
+	return dek, nil
+}
+
+// encrypt encrypts the i-th share.
+func (s *Side) encrypt(ctx context.Context, i int, share, associatedData []byte) (Share, error) {
+	resp, err := s.Client.Encrypt(ctx, s.Enclave, &kms.EncryptRequest{
+		Name:           s.Key,
+		Plaintext:      share,
+		AssociatedData: shareAssociatedData(i, associatedData),
+	})
+	if err != nil {
+		return Share{}, s.error(i, "encrypt", s.Enclave, s.Key, err)
+	}
+	return Share{
+		Enclave:    s.Enclave,
+		Key:        s.Key,
+		Version:    resp[0].Version,
+		Ciphertext: resp[0].Ciphertext,
+	}, nil
+}
+
+// decrypt decrypts the i-th share. The share's enclave and key
+// must be the Side's or one of its Previous keys, such that
+// envelopes remain decryptable once the Side has been configured
+// to use another enclave or key for new shares.
+func (s *Side) decrypt(ctx context.Context, i int, share *Share, associatedData []byte) ([]byte, error) {
+	ref := KeyRef{Enclave: share.Enclave, Key: share.Key}
+	if ref != (KeyRef{Enclave: s.Enclave, Key: s.Key}) && !slices.Contains(s.Previous, ref) {
+		return nil, s.error(i, "decrypt", share.Enclave, share.Key, ErrKeyNotAllowed)
+	}
+
+	resp, err := s.Client.Decrypt(ctx, share.Enclave, &kms.DecryptRequest{
+		Name:           share.Key,
+		Version:        share.Version,
+		Ciphertext:     share.Ciphertext,
+		AssociatedData: shareAssociatedData(i, associatedData),
+	})
+	if err != nil {
+		return nil, s.error(i, "decrypt", share.Enclave, share.Key, err)
+	}
+	return resp[0].Plaintext, nil
+}
+
+func (s *Side) error(i int, op, enclave, key string, err error) *SideError {
+	name := s.Name
+	if name == "" {
+		name = [2]string{"first", "second"}[i]
+	}
+	return &SideError{
+		Side:    name,
+		Op:      op,
+		Enclave: enclave,
+		Key:     key,
+		Err:     err,
+	}
+}
+
+// shareAssociatedData binds the associated data to the position of
+// a share. Hence, the shares of an Envelope cannot be swapped.
+func shareAssociatedData(i int, associatedData []byte) []byte {
+	return append([]byte{byte(i + 1)}, associatedData...)
+}
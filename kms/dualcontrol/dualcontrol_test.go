@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package dualcontrol
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"testing"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/kmstest"
+)
+
+func TestDualControl(t *testing.T) {
+	t.Parallel()
+
+	first, _ := newTestClient(t, "vault-a", "split-key")
+	second, _ := newTestClient(t, "vault-b", "split-key")
+	dc := &DualControl{
+		First:  Side{Client: first, Enclave: "vault-a", Key: "split-key"},
+		Second: Side{Name: "security", Client: second, Enclave: "vault-b", Key: "split-key"},
+	}
+
+	dek, env, err := dc.GenerateKey(context.Background(), []byte("object"))
+	if err != nil {
+		t.Fatalf("Failed to generate DEK: %v", err)
+	}
+	if len(dek) != KeySize {
+		t.Fatalf("DEK length mismatch: got %d - want %d", len(dek), KeySize)
+	}
+	if env.First.Enclave != "vault-a" || env.Second.Enclave != "vault-b" {
+		t.Fatalf("Enclave mismatch: got '%s' and '%s'", env.First.Enclave, env.Second.Enclave)
+	}
+
+	plaintext, err := dc.Decrypt(context.Background(), env, []byte("object"))
+	if err != nil {
+		t.Fatalf("Failed to decrypt DEK: %v", err)
+	}
+	if !bytes.Equal(plaintext, dek) {
+		t.Fatal("DEK mismatch")
+	}
+
+	if _, err = dc.Decrypt(context.Background(), env, []byte("other-object")); err == nil {
+		t.Fatal("Decrypted DEK with wrong associated data")
+	}
+	swapped := &Envelope{First: env.Second, Second: env.First}
+	if _, err = (&DualControl{First: dc.Second, Second: dc.First}).Decrypt(context.Background(), swapped, []byte("object")); err == nil {
+		t.Fatal("Decrypted DEK with swapped shares")
+	}
+}
+
+func TestDualControl_SameClient(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t, "vault-a", "split-key")
+	dc := &DualControl{
+		First:  Side{Client: client, Enclave: "vault-a", Key: "split-key"},
+		Second: Side{Client: client, Enclave: "vault-b", Key: "split-key"},
+	}
+	if _, _, err := dc.GenerateKey(context.Background(), nil); !errors.Is(err, ErrSameClient) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, ErrSameClient)
+	}
+	if _, err := dc.Decrypt(context.Background(), &Envelope{}, nil); !errors.Is(err, ErrSameClient) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, ErrSameClient)
+	}
+}
+
+func TestDualControl_KeyNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	first, _ := newTestClient(t, "vault-a", "old-key", "new-key")
+	second, _ := newTestClient(t, "vault-b", "split-key")
+	dc := &DualControl{
+		First:  Side{Client: first, Enclave: "vault-a", Key: "old-key"},
+		Second: Side{Name: "security", Client: second, Enclave: "vault-b", Key: "split-key"},
+	}
+	dek, env, err := dc.GenerateKey(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Failed to generate DEK: %v", err)
+	}
+
+	// Once the first side switches to a new key, envelopes of the
+	// old key can only be decrypted if it is listed as previous key.
+	dc.First.Key = "new-key"
+	if _, err = dc.Decrypt(context.Background(), env, nil); !errors.Is(err, ErrKeyNotAllowed) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, ErrKeyNotAllowed)
+	}
+	dc.First.Previous = []KeyRef{{Enclave: "vault-a", Key: "old-key"}}
+	plaintext, err := dc.Decrypt(context.Background(), env, nil)
+	if err != nil {
+		t.Fatalf("Failed to decrypt DEK with previous key: %v", err)
+	}
+	if !bytes.Equal(plaintext, dek) {
+		t.Fatal("DEK mismatch")
+	}
+
+	for i, share := range []Share{
+		{Enclave: "other-vault", Key: "split-key"},
+		{Enclave: "vault-b", Key: "other-key"},
+		{Enclave: "vault-a", Key: "old-key"}, // Previous key of the first side
+	} {
+		modified := *env
+		modified.Second.Enclave, modified.Second.Key = share.Enclave, share.Key
+		_, err = dc.Decrypt(context.Background(), &modified, nil)
+		if !errors.Is(err, ErrKeyNotAllowed) {
+			t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, ErrKeyNotAllowed)
+		}
+		var sErr *SideError
+		if !errors.As(err, &sErr) || sErr.Side != "security" {
+			t.Fatalf("Test %d: error does not name the second side: %v", i, err)
+		}
+	}
+}
+
+func TestDualControl_SideError(t *testing.T) {
+	t.Parallel()
+
+	first, firstServer := newTestClient(t, "vault-a", "split-key")
+	second, secondServer := newTestClient(t, "vault-b", "split-key")
+	dc := &DualControl{
+		First:  Side{Client: first, Enclave: "vault-a", Key: "split-key"},
+		Second: Side{Name: "security", Client: second, Enclave: "vault-b", Key: "split-key"},
+	}
+	_, env, err := dc.GenerateKey(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Failed to generate DEK: %v", err)
+	}
+
+	// A side refuses if its client's identity is not allowed to
+	// use the key, or if the key does not exist on its cluster.
+	noKeyFirst, _ := newTestClient(t, "vault-a")
+	noKeySecond, _ := newTestClient(t, "vault-b")
+	clients := map[error][2]*kms.Client{
+		nil:                {first, second},
+		kms.ErrPermission:  {newUnknownClient(t, firstServer), newUnknownClient(t, secondServer)},
+		kms.ErrKeyNotFound: {noKeyFirst, noKeySecond},
+	}
+	for i, test := range sideErrorTests {
+		dc := &DualControl{First: dc.First, Second: dc.Second}
+		dc.First.Client, dc.Second.Client = clients[test.FirstErr][0], clients[test.SecondErr][1]
+
+		_, err := dc.Decrypt(context.Background(), env, nil)
+		if err == nil {
+			t.Fatalf("Test %d: decrypted DEK while a side refused", i)
+		}
+		for _, side := range test.Sides {
+			if !strings.Contains(err.Error(), side+" side failed to decrypt") {
+				t.Fatalf("Test %d: error does not name the %s side: %v", i, side, err)
+			}
+		}
+		var sErr *SideError
+		if !errors.As(err, &sErr) {
+			t.Fatalf("Test %d: error is not a SideError: %v", i, err)
+		}
+		if sErr.Side != test.Sides[0] {
+			t.Fatalf("Test %d: side mismatch: got '%s' - want '%s'", i, sErr.Side, test.Sides[0])
+		}
+		if !errors.Is(err, kms.ErrPermission) {
+			t.Fatalf("Test %d: error does not wrap '%v': %v", i, kms.ErrPermission, err)
+		}
+	}
+
+	dc.First.Client = clients[kms.ErrPermission][0]
+	if _, _, err = dc.GenerateKey(context.Background(), nil); err == nil {
+		t.Fatal("Generated DEK while a side refused")
+	}
+}
+
+var sideErrorTests = []struct {
+	FirstErr  error
+	SecondErr error
+	Sides     []string
+}{
+	{FirstErr: kms.ErrPermission, Sides: []string{"first"}},
+	{SecondErr: kms.ErrPermission, Sides: []string{"security"}},
+	{FirstErr: kms.ErrPermission, SecondErr: kms.ErrKeyNotFound, Sides: []string{"first", "security"}},
+}
+
+// newTestClient starts a fake KMS server with the given enclave
+// containing the given keys. It returns a client for the server
+// and the server, which is shut down once the test ends.
+func newTestClient(t *testing.T, enclave string, keys ...string) (*kms.Client, *kmstest.Server) {
+	server := kmstest.NewServer(nil)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := kmstest.NewClient(t, server)
+	if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: enclave}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	for _, key := range keys {
+		if err := client.CreateKey(ctx, enclave, &kms.CreateKeyRequest{Name: key}); err != nil {
+			t.Fatalf("Failed to create key '%s': %v", key, err)
+		}
+	}
+	return client, server
+}
+
+// newUnknownClient returns a client for the server whose
+// identity does not exist within any enclave.
+func newUnknownClient(t *testing.T, server *kmstest.Server) *kms.Client {
+	key, err := mtls.GenerateKeyEdDSA(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate API key: %v", err)
+	}
+	return kmstest.NewClientWithKey(t, key, server)
+}
@@ -0,0 +1,752 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package encfs implements an encrypted filesystem on top of
+// a local directory using KMS-managed keys.
+//
+// An FS encrypts file contents and file names before storing
+// them in its directory. Hence, files can be stored on untrusted
+// disks or shared volumes:
+//
+//	fsys, err := encfs.New(ctx, &encfs.Config{
+//		Dir:     "/mnt/shared",
+//		Client:  client,
+//		Enclave: "storage",
+//		Key:     "fs-key",
+//	})
+//	if err != nil {
+//		// handle error
+//	}
+//	defer fsys.Close()
+//
+//	err = fsys.WriteFile("reports/2025.csv", data, 0o640)
+//
+// Each file is encrypted in authenticated chunks with its own
+// data encryption key (DEK), generated by the KMS and stored,
+// encrypted, in the file's header. Files support random-access
+// reads and truncating or reordering chunks is detected.
+//
+// Each directory has its own DEK as well, stored in a metadata
+// file within the directory. File names are encrypted
+// deterministically with a key derived from the directory's DEK.
+// Hence, files can be located by their name while the encrypted
+// names only reveal the length of the plaintext names.
+//
+// An FS implements fs.FS, fs.ReadDirFS and fs.StatFS.
+package encfs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/openstor/kms-go/kms"
+)
+
+// DefaultChunkSize is the default size of plaintext chunks.
+const DefaultChunkSize = 64 * 1024
+
+// MaxChunkSize is the max. size of plaintext chunks.
+const MaxChunkSize = 16 * 1024 * 1024
+
+// metadataFile is the name of the file within each directory
+// that contains the directory's encrypted DEK. Encrypted file
+// names never start with a '.'.
+const metadataFile = ".encfs"
+
+// tempPrefix is the prefix of temporary files and directories.
+// Like metadataFile, they are not listed by ReadDir.
+const tempPrefix = ".encfs-tmp-"
+
+// ErrDirNotEmpty is returned when trying to remove
+// a directory that is not empty.
+var ErrDirNotEmpty = errors.New("encfs: directory not empty")
+
+// Config is a structure containing configuration options
+// for encrypted filesystems.
+type Config struct {
+	// Dir is the directory containing the encrypted files.
+	Dir string
+
+	// Client is the KMS client used to generate and decrypt
+	// data encryption keys.
+	Client *kms.Client
+
+	// Enclave is the enclave containing the Key.
+	Enclave string
+
+	// Key is the name of the KMS key used to encrypt
+	// data encryption keys.
+	Key string
+
+	// ChunkSize is the size of plaintext chunks of new files.
+	// If <= 0, defaults to DefaultChunkSize. Larger chunks
+	// reduce the storage overhead while smaller chunks reduce
+	// the cost of random-access reads.
+	ChunkSize int
+}
+
+// New returns a new FS that stores encrypted files within
+// conf.Dir. If conf.Dir is not an encrypted filesystem yet,
+// New initializes it.
+func New(ctx context.Context, conf *Config) (*FS, error) {
+	chunkSize := conf.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	if chunkSize > MaxChunkSize {
+		return nil, errors.New("encfs: chunk size too large")
+	}
+
+	root, err := os.OpenRoot(conf.Dir)
+	if err != nil {
+		return nil, err
+	}
+	fsys := &FS{
+		dir:       conf.Dir,
+		root:      root,
+		client:    conf.Client,
+		enclave:   conf.Enclave,
+		key:       conf.Key,
+		chunkSize: chunkSize,
+		dirs:      map[string]*dirKey{},
+	}
+
+	_, err = root.Stat(metadataFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		err = fsys.createDirKey(ctx, ".", ".")
+	}
+	if err == nil {
+		_, err = fsys.dirKey(ctx, ".")
+	}
+	if err != nil {
+		root.Close()
+		return nil, err
+	}
+	return fsys, nil
+}
+
+// FS is an encrypted filesystem. It can be used concurrently.
+//
+// The methods of fs.FS do not take a context. Hence, the FS
+// uses the timeouts of its KMS client when fetching keys.
+type FS struct {
+	dir       string
+	root      *os.Root
+	client    *kms.Client
+	enclave   string
+	key       string
+	chunkSize int
+
+	mu   sync.Mutex
+	dirs map[string]*dirKey // Plaintext directory path -> key
+}
+
+var ( // compiler checks
+	_ fs.FS        = (*FS)(nil)
+	_ fs.ReadDirFS = (*FS)(nil)
+	_ fs.StatFS    = (*FS)(nil)
+)
+
+// dirKey contains the location and name cipher of a directory.
+type dirKey struct {
+	path  string // Encrypted path relative to the FS root
+	names *nameCipher
+}
+
+// Open opens the named file or directory for reading.
+func (f *FS) Open(name string) (fs.File, error) {
+	ctx := context.Background()
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	p, err := f.resolve(ctx, name)
+	if err != nil {
+		return nil, pathError("open", name, err)
+	}
+
+	file, err := f.root.Open(p)
+	if err != nil {
+		return nil, pathError("open", name, err)
+	}
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, pathError("open", name, err)
+	}
+	if stat.IsDir() {
+		return &dir{fs: f, file: file, name: name, info: dirInfo(name, stat)}, nil
+	}
+
+	encFile, err := f.openFile(ctx, name, file, stat)
+	if err != nil {
+		file.Close()
+		return nil, pathError("open", name, err)
+	}
+	return encFile, nil
+}
+
+// Stat returns the FileInfo of the named file or directory.
+// Stat does not fetch any keys from the KMS for files.
+func (f *FS) Stat(name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrInvalid}
+	}
+	p, err := f.resolve(context.Background(), name)
+	if err != nil {
+		return nil, pathError("stat", name, err)
+	}
+	info, err := f.stat(p, path.Base(name))
+	if err != nil {
+		return nil, pathError("stat", name, err)
+	}
+	return info, nil
+}
+
+// ReadDir reads the named directory and returns its
+// entries sorted by plaintext name.
+func (f *FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	file, err := f.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	d, ok := file.(*dir)
+	if !ok {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: errors.New("not a directory")}
+	}
+	entries, err := d.ReadDir(-1)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int { return strings.Compare(a.Name(), b.Name()) })
+	return entries, nil
+}
+
+// Create creates or replaces the named file and returns
+// a Writer that encrypts data written to it. The Writer
+// must be closed to complete the file.
+//
+// The Writer writes to a temporary file that replaces the
+// named file once the Writer has been closed successfully.
+// Until then, and if writing fails, the named file remains
+// unchanged.
+//
+// The file's DEK is bound to its name. Hence, files cannot
+// be moved without decrypting and encrypting them again.
+func (f *FS) Create(name string, perm fs.FileMode) (*Writer, error) {
+	ctx := context.Background()
+	if !fs.ValidPath(name) || name == "." {
+		return nil, &fs.PathError{Op: "create", Path: name, Err: fs.ErrInvalid}
+	}
+	p, err := f.resolve(ctx, name)
+	if err != nil {
+		return nil, pathError("create", name, err)
+	}
+	if stat, err := f.root.Stat(p); err == nil && stat.IsDir() {
+		return nil, &fs.PathError{Op: "create", Path: name, Err: errors.New("is a directory")}
+	}
+
+	keys, err := f.client.GenerateKey(ctx, f.enclave, &kms.GenerateKeyRequest{
+		Name:           f.key,
+		AssociatedData: fileAssociatedData(name),
+	})
+	if err != nil {
+		return nil, pathError("create", name, err)
+	}
+	dek := keys[0].Plaintext
+	defer clear(dek)
+
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, pathError("create", name, err)
+	}
+	h := &header{
+		ChunkSize:  f.chunkSize,
+		Key:        f.key,
+		Version:    keys[0].Version,
+		Ciphertext: keys[0].Ciphertext,
+	}
+	hdr := h.Marshal()
+
+	tmp := path.Join(path.Dir(p), tempName())
+	file, err := f.root.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return nil, pathError("create", name, err)
+	}
+	if _, err = file.Write(hdr); err != nil {
+		file.Close()
+		f.root.Remove(tmp)
+		return nil, pathError("create", name, err)
+	}
+	return &Writer{
+		fs:     f,
+		path:   p,
+		tmp:    tmp,
+		file:   file,
+		aead:   aead,
+		header: hdr,
+		buf:    make([]byte, 0, f.chunkSize),
+		sealed: make([]byte, 0, f.chunkSize+tagSize),
+	}, nil
+}
+
+// WriteFile encrypts data and writes it to the named
+// file, creating it if necessary.
+func (f *FS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	w, err := f.Create(name, perm)
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(data); err != nil {
+		w.Close()
+		return pathError("write", name, err)
+	}
+	if err = w.Close(); err != nil {
+		return pathError("write", name, err)
+	}
+	return nil
+}
+
+// Mkdir creates a new directory with its own DEK.
+// The parent directory must exist.
+func (f *FS) Mkdir(name string, perm fs.FileMode) error {
+	ctx := context.Background()
+	if !fs.ValidPath(name) || name == "." {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: fs.ErrInvalid}
+	}
+	p, err := f.resolve(ctx, name)
+	if err != nil {
+		return pathError("mkdir", name, err)
+	}
+	if err = f.root.Mkdir(p, perm); err != nil {
+		return pathError("mkdir", name, err)
+	}
+	if err = f.createDirKey(ctx, name, p); err != nil {
+		f.root.Remove(p)
+		return pathError("mkdir", name, err)
+	}
+	return nil
+}
+
+// Remove removes the named file or empty directory.
+//
+// A directory is renamed to a temporary name before its
+// metadata file is removed. Hence, a directory is either
+// removed or remains decryptable, even if Remove fails.
+func (f *FS) Remove(name string) error {
+	ctx := context.Background()
+	if !fs.ValidPath(name) || name == "." {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrInvalid}
+	}
+	p, err := f.resolve(ctx, name)
+	if err != nil {
+		return pathError("remove", name, err)
+	}
+	stat, err := f.root.Stat(p)
+	if err != nil {
+		return pathError("remove", name, err)
+	}
+	if !stat.IsDir() {
+		return pathError("remove", name, f.root.Remove(p))
+	}
+
+	entries, err := f.readDirNames(p)
+	if err != nil {
+		return pathError("remove", name, err)
+	}
+	if slices.ContainsFunc(entries, func(e string) bool { return !strings.HasPrefix(e, ".") }) {
+		return &fs.PathError{Op: "remove", Path: name, Err: ErrDirNotEmpty}
+	}
+
+	tmp := path.Join(path.Dir(p), tempName())
+	if err = f.rename(p, tmp); err != nil {
+		return pathError("remove", name, err)
+	}
+	f.mu.Lock()
+	delete(f.dirs, name)
+	f.mu.Unlock()
+
+	// The directory is not reachable anymore. Remove the temporary
+	// files of unfinished writes and the metadata file last.
+	if entries, err = f.readDirNames(tmp); err != nil {
+		return pathError("remove", name, err)
+	}
+	for _, entry := range entries {
+		if entry == metadataFile {
+			continue
+		}
+		if err = f.root.Remove(path.Join(tmp, entry)); err != nil {
+			return pathError("remove", name, err)
+		}
+	}
+	if err = f.root.Remove(path.Join(tmp, metadataFile)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return pathError("remove", name, err)
+	}
+	return pathError("remove", name, f.root.Remove(tmp))
+}
+
+// readDirNames returns the names of all entries of the
+// directory at the encrypted path p.
+func (f *FS) readDirNames(p string) ([]string, error) {
+	file, err := f.root.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return file.Readdirnames(-1)
+}
+
+// rename renames the encrypted path oldpath to newpath.
+//
+// os.Root does not support renaming files before Go 1.25.
+// Hence, rename has to rename the files by their paths within
+// the FS directory, which may follow symlinks out of the root.
+// Before renaming, it checks that the parent directories of
+// both paths are the directories opened through the root.
+func (f *FS) rename(oldpath, newpath string) error {
+	if err := f.checkDir(path.Dir(oldpath)); err != nil {
+		return err
+	}
+	if err := f.checkDir(path.Dir(newpath)); err != nil {
+		return err
+	}
+	return os.Rename(filepath.Join(f.dir, filepath.FromSlash(oldpath)), filepath.Join(f.dir, filepath.FromSlash(newpath)))
+}
+
+// checkDir returns an error if the directory p, opened through
+// the root, is not the directory at p within the FS directory.
+// For example, because p is a symlink or the FS directory has
+// been moved.
+func (f *FS) checkDir(p string) error {
+	file, err := f.root.Open(p)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	want, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	got, err := os.Lstat(filepath.Join(f.dir, filepath.FromSlash(p)))
+	if err != nil {
+		return err
+	}
+	if !got.IsDir() || !os.SameFile(got, want) {
+		return errors.New("encfs: directory '" + p + "' is a symlink or has been moved")
+	}
+	return nil
+}
+
+// Close closes the FS. Files opened by the FS remain open.
+func (f *FS) Close() error { return f.root.Close() }
+
+// resolve returns the encrypted path of the plaintext path.
+func (f *FS) resolve(ctx context.Context, name string) (string, error) {
+	if name == "." {
+		return ".", nil
+	}
+	parent, err := f.dirKey(ctx, path.Dir(name))
+	if err != nil {
+		return "", err
+	}
+	encName, err := parent.names.Encrypt(path.Base(name))
+	if err != nil {
+		return "", err
+	}
+	return path.Join(parent.path, encName), nil
+}
+
+// dirKey returns the key of the named directory. It loads
+// the keys of the directory and its parents, if necessary.
+func (f *FS) dirKey(ctx context.Context, name string) (*dirKey, error) {
+	f.mu.Lock()
+	key, ok := f.dirs[name]
+	f.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	p, err := f.resolve(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	file, err := f.root.Open(path.Join(p, metadataFile))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	h, _, err := readHeader(file)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.client.Decrypt(ctx, f.enclave, &kms.DecryptRequest{
+		Name:           h.Key,
+		Version:        h.Version,
+		Ciphertext:     h.Ciphertext,
+		AssociatedData: dirAssociatedData(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	dek := resp[0].Plaintext
+	defer clear(dek)
+
+	names, err := newNameCipher(dek)
+	if err != nil {
+		return nil, err
+	}
+	key = &dirKey{path: p, names: names}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if k, ok := f.dirs[name]; ok { // Loaded concurrently
+		return k, nil
+	}
+	f.dirs[name] = key
+	return key, nil
+}
+
+// createDirKey generates a new DEK for the named directory,
+// located at the encrypted path p, and writes it, encrypted,
+// to the directory's metadata file.
+func (f *FS) createDirKey(ctx context.Context, name, p string) error {
+	keys, err := f.client.GenerateKey(ctx, f.enclave, &kms.GenerateKeyRequest{
+		Name:           f.key,
+		AssociatedData: dirAssociatedData(name),
+	})
+	if err != nil {
+		return err
+	}
+	clear(keys[0].Plaintext) // Decrypted on demand by dirKey
+
+	h := &header{
+		ChunkSize:  f.chunkSize,
+		Key:        f.key,
+		Version:    keys[0].Version,
+		Ciphertext: keys[0].Ciphertext,
+	}
+	file, err := f.root.OpenFile(path.Join(p, metadataFile), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(h.Marshal()); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// openFile decrypts the DEK of the named file and verifies
+// that the file has not been truncated.
+func (f *FS) openFile(ctx context.Context, name string, file *os.File, stat fs.FileInfo) (*File, error) {
+	h, hdr, err := readHeader(file)
+	if err != nil {
+		return nil, err
+	}
+	size, chunks, err := plaintextSize(h, stat.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.client.Decrypt(ctx, f.enclave, &kms.DecryptRequest{
+		Name:           h.Key,
+		Version:        h.Version,
+		Ciphertext:     h.Ciphertext,
+		AssociatedData: fileAssociatedData(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	dek := resp[0].Plaintext
+	defer clear(dek)
+
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+	encFile := &File{
+		file:   file,
+		aead:   aead,
+		header: hdr,
+		info: &fileInfo{
+			name:    path.Base(name),
+			size:    size,
+			mode:    stat.Mode(),
+			modTime: stat.ModTime(),
+		},
+		chunkSize: int64(h.ChunkSize),
+		chunks:    chunks,
+		cached:    -1,
+		buf:       make([]byte, 0, h.ChunkSize),
+		sealed:    make([]byte, 0, h.ChunkSize+tagSize),
+	}
+
+	// Verify the final chunk to detect truncated files
+	// before reporting a plaintext size.
+	if _, err = encFile.readChunk(chunks - 1); err != nil {
+		return nil, err
+	}
+	return encFile, nil
+}
+
+// stat returns the plaintext FileInfo of the file or
+// directory at the encrypted path p.
+func (f *FS) stat(p, name string) (*fileInfo, error) {
+	stat, err := f.root.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if stat.IsDir() {
+		return dirInfo(name, stat), nil
+	}
+
+	file, err := f.root.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	h, _, err := readHeader(file)
+	if err != nil {
+		return nil, err
+	}
+	size, _, err := plaintextSize(h, stat.Size())
+	if err != nil {
+		return nil, err
+	}
+	return &fileInfo{
+		name:    name,
+		size:    size,
+		mode:    stat.Mode(),
+		modTime: stat.ModTime(),
+	}, nil
+}
+
+// dir is a directory opened for reading.
+type dir struct {
+	fs   *FS
+	file *os.File
+	name string
+	info *fileInfo
+}
+
+func (d *dir) Stat() (fs.FileInfo, error) { return d.info, nil }
+
+func (d *dir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: errors.New("is a directory")}
+}
+
+func (d *dir) Close() error { return d.file.Close() }
+
+// ReadDir returns up to n directory entries with
+// decrypted names. If n <= 0, it returns all
+// remaining entries.
+func (d *dir) ReadDir(n int) ([]fs.DirEntry, error) {
+	key, err := d.fs.dirKey(context.Background(), d.name)
+	if err != nil {
+		return nil, pathError("readdir", d.name, err)
+	}
+
+	var entries []fs.DirEntry
+	for n <= 0 || len(entries) < n {
+		limit := -1
+		if n > 0 {
+			limit = n - len(entries)
+		}
+		osEntries, err := d.file.ReadDir(limit)
+		for _, entry := range osEntries {
+			if strings.HasPrefix(entry.Name(), ".") { // Metadata and temporary files
+				continue
+			}
+			name, err := key.names.Decrypt(entry.Name())
+			if err != nil {
+				return entries, pathError("readdir", d.name, err)
+			}
+			entries = append(entries, &dirEntry{
+				fs:   d.fs,
+				path: path.Join(key.path, entry.Name()),
+				name: name,
+				mode: entry.Type(),
+			})
+		}
+		if err == io.EOF {
+			if n > 0 && len(entries) == 0 {
+				return nil, io.EOF
+			}
+			return entries, nil
+		}
+		if err != nil {
+			return entries, pathError("readdir", d.name, err)
+		}
+		if n <= 0 {
+			return entries, nil
+		}
+	}
+	return entries, nil
+}
+
+// dirEntry is a directory entry with a decrypted name.
+type dirEntry struct {
+	fs   *FS
+	path string // Encrypted path relative to the FS root
+	name string
+	mode fs.FileMode
+}
+
+func (e *dirEntry) Name() string               { return e.name }
+func (e *dirEntry) IsDir() bool                { return e.mode.IsDir() }
+func (e *dirEntry) Type() fs.FileMode          { return e.mode.Type() }
+func (e *dirEntry) Info() (fs.FileInfo, error) { return e.fs.stat(e.path, e.name) }
+
+// dirInfo returns the FileInfo of a plaintext directory.
+func dirInfo(name string, stat fs.FileInfo) *fileInfo {
+	return &fileInfo{
+		name:    path.Base(name),
+		mode:    stat.Mode(),
+		modTime: stat.ModTime(),
+	}
+}
+
+// tempName returns a random name for a temporary file
+// or directory.
+func tempName() string {
+	var b [16]byte
+	rand.Read(b[:])
+	return tempPrefix + hex.EncodeToString(b[:])
+}
+
+// fileAssociatedData returns the associated data that binds
+// a file's DEK to its plaintext path.
+func fileAssociatedData(name string) []byte { return []byte("file:" + name) }
+
+// dirAssociatedData returns the associated data that binds
+// a directory's DEK to its plaintext path.
+func dirAssociatedData(name string) []byte { return []byte("dir:" + name) }
+
+// pathError returns a *fs.PathError for the plaintext path
+// name. It replaces any path errors referring to encrypted
+// paths. It returns nil if err is nil.
+func pathError(op, name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var pErr *fs.PathError
+	if errors.As(err, &pErr) {
+		err = pErr.Err
+	}
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
@@ -0,0 +1,385 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package encfs
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/kmstest"
+)
+
+const testChunkSize = 64
+
+func TestFS(t *testing.T) {
+	t.Parallel()
+
+	fsys := newTestFS(t, t.TempDir(), newTestClient(t))
+	if err := fsys.Mkdir("dir", 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := fsys.Mkdir("dir/sub", 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	files := map[string][]byte{}
+	for i, size := range []int{0, 1, testChunkSize - 1, testChunkSize, testChunkSize + 1, 3*testChunkSize + 5} {
+		name := []string{"a.txt", "b.bin", "dir/c.txt", "dir/d", "dir/sub/e.json", "f"}[i]
+		data := make([]byte, size)
+		rand.Read(data)
+
+		if err := fsys.WriteFile(name, data, 0o644); err != nil {
+			t.Fatalf("Failed to write file '%s': %v", name, err)
+		}
+		files[name] = data
+	}
+
+	if err := fstest.TestFS(fsys, "a.txt", "b.bin", "dir/c.txt", "dir/d", "dir/sub/e.json", "f"); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range files {
+		b, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			t.Fatalf("Failed to read file '%s': %v", name, err)
+		}
+		if !bytes.Equal(b, data) {
+			t.Fatalf("File '%s' content mismatch", name)
+		}
+	}
+}
+
+func TestFS_ReadAt(t *testing.T) {
+	t.Parallel()
+
+	fsys := newTestFS(t, t.TempDir(), newTestClient(t))
+	data := make([]byte, 5*testChunkSize+17)
+	rand.Read(data)
+	if err := fsys.WriteFile("file", data, 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	file, err := fsys.Open("file")
+	if err != nil {
+		t.Fatalf("Failed to open file: %v", err)
+	}
+	defer file.Close()
+
+	r := file.(io.ReaderAt)
+	for i, test := range []struct{ Off, Len int }{
+		{0, 1}, {0, testChunkSize}, {testChunkSize - 1, 2}, {2*testChunkSize + 3, 2 * testChunkSize}, {len(data) - 5, 5}, {1, len(data) - 1},
+	} {
+		b := make([]byte, test.Len)
+		if _, err := r.ReadAt(b, int64(test.Off)); err != nil {
+			t.Fatalf("Test %d: failed to read at %d: %v", i, test.Off, err)
+		}
+		if !bytes.Equal(b, data[test.Off:test.Off+test.Len]) {
+			t.Fatalf("Test %d: content mismatch at offset %d", i, test.Off)
+		}
+	}
+	if _, err = r.ReadAt(make([]byte, 10), int64(len(data)-5)); err != io.EOF {
+		t.Fatalf("Reading past the end: got '%v' - want '%v'", err, io.EOF)
+	}
+}
+
+func TestFS_EncryptedNames(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	client := newTestClient(t)
+	fsys := newTestFS(t, dir, client)
+	if err := fsys.Mkdir("secret-dir", 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := fsys.WriteFile("secret-dir/secret-file", []byte("secret content"), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	err := filepath.WalkDir(dir, func(p string, _ fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.Contains(p, "secret") {
+			return errors.New("plaintext name on disk: " + p)
+		}
+		if b, _ := os.ReadFile(p); bytes.Contains(b, []byte("secret content")) {
+			return errors.New("plaintext content on disk: " + p)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// File names are deterministic. Hence, another FS
+	// for the same directory finds the same files.
+	other := newTestFS(t, dir, client)
+	b, err := fs.ReadFile(other, "secret-dir/secret-file")
+	if err != nil {
+		t.Fatalf("Failed to read file: %v", err)
+	}
+	if string(b) != "secret content" {
+		t.Fatalf("Content mismatch: got '%s' - want '%s'", b, "secret content")
+	}
+
+	if err = other.Remove("secret-dir"); !errors.Is(err, ErrDirNotEmpty) {
+		t.Fatalf("Removing non-empty directory: got '%v' - want '%v'", err, ErrDirNotEmpty)
+	}
+	if err = other.Remove("secret-dir/secret-file"); err != nil {
+		t.Fatalf("Failed to remove file: %v", err)
+	}
+	if err = other.Remove("secret-dir"); err != nil {
+		t.Fatalf("Failed to remove directory: %v", err)
+	}
+	if _, err = other.Stat("secret-dir"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Stat of removed directory: got '%v' - want '%v'", err, fs.ErrNotExist)
+	}
+}
+
+func TestFS_Tamper(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fsys := newTestFS(t, dir, newTestClient(t))
+
+	data := make([]byte, 4*testChunkSize+10)
+	rand.Read(data)
+	for i, tamper := range tamperTests {
+		name := "file-" + string(rune('a'+i))
+		if err := fsys.WriteFile(name, data, 0o644); err != nil {
+			t.Fatalf("Test %d: failed to write file: %v", i, err)
+		}
+
+		p, err := fsys.resolve(context.Background(), name)
+		if err != nil {
+			t.Fatalf("Test %d: failed to resolve file: %v", i, err)
+		}
+		p = filepath.Join(dir, p)
+		b, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatalf("Test %d: failed to read file: %v", i, err)
+		}
+		hdr, _, err := readHeader(bytes.NewReader(b))
+		if err != nil {
+			t.Fatalf("Test %d: failed to read header: %v", i, err)
+		}
+		if err = os.WriteFile(p, tamper(b, hdr.Size()), 0o644); err != nil {
+			t.Fatalf("Test %d: failed to write file: %v", i, err)
+		}
+
+		if _, err = fs.ReadFile(fsys, name); err == nil {
+			t.Fatalf("Test %d: read tampered file successfully", i)
+		}
+	}
+}
+
+func TestFS_Create(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fsys := newTestFS(t, dir, newTestClient(t))
+	if err := fsys.WriteFile("file", []byte("v1"), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	// The file is only replaced once the Writer is closed.
+	w, err := fsys.Create("file", 0o644)
+	if err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	if _, err = w.Write(bytes.Repeat([]byte("v2"), 2*testChunkSize)); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if b, err := fs.ReadFile(fsys, "file"); err != nil || string(b) != "v1" {
+		t.Fatalf("Content mismatch before closing: got '%s' - want '%s': %v", b, "v1", err)
+	}
+	if entries, err := fsys.ReadDir("."); err != nil || len(entries) != 1 || entries[0].Name() != "file" {
+		t.Fatalf("Directory entries mismatch: got %v: %v", entries, err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("Failed to close file: %v", err)
+	}
+	if b, err := fs.ReadFile(fsys, "file"); err != nil || !bytes.Equal(b, bytes.Repeat([]byte("v2"), 2*testChunkSize)) {
+		t.Fatalf("Content mismatch after closing: %v", err)
+	}
+
+	// A failed write leaves the file unchanged.
+	if w, err = fsys.Create("file", 0o644); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	w.file.Close()
+	if _, err = w.Write(bytes.Repeat([]byte("v3"), 2*testChunkSize)); err == nil {
+		t.Fatal("Wrote to closed file")
+	}
+	if err = w.Close(); err == nil {
+		t.Fatal("Closed failed Writer successfully")
+	}
+	if b, err := fs.ReadFile(fsys, "file"); err != nil || !bytes.Equal(b, bytes.Repeat([]byte("v2"), 2*testChunkSize)) {
+		t.Fatalf("Content mismatch after failed write: %v", err)
+	}
+
+	if err = fsys.Mkdir("dir", 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if _, err = fsys.Create("dir", 0o644); err == nil {
+		t.Fatal("Created file over directory")
+	}
+	if entries, err := os.ReadDir(dir); err != nil || len(entries) != 3 { // metadata, file and dir
+		t.Fatalf("Temporary files have not been removed: got %v: %v", entries, err)
+	}
+}
+
+func TestFS_Remove(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fsys := newTestFS(t, dir, newTestClient(t))
+	if err := fsys.Mkdir("dir", 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	// An unfinished write does not keep the directory from
+	// being removed but fails once the directory is gone.
+	w, err := fsys.Create("dir/file", 0o644)
+	if err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	if err = fsys.Remove("dir"); err != nil {
+		t.Fatalf("Failed to remove directory: %v", err)
+	}
+	if err = w.Close(); err == nil {
+		t.Fatal("Created file within removed directory")
+	}
+	if _, err = fsys.Stat("dir"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Stat of removed directory: got '%v' - want '%v'", err, fs.ErrNotExist)
+	}
+	if entries, err := os.ReadDir(dir); err != nil || len(entries) != 1 || entries[0].Name() != metadataFile {
+		t.Fatalf("Directory has not been removed completely: got %v: %v", entries, err)
+	}
+
+	// Removing a directory that has been detached but not deleted
+	// by a previous Remove doesn't affect other directories.
+	if err = fsys.Mkdir("dir", 0o755); err != nil {
+		t.Fatalf("Failed to create directory again: %v", err)
+	}
+	if err = os.Mkdir(filepath.Join(dir, tempName()), 0o755); err != nil {
+		t.Fatalf("Failed to create temporary directory: %v", err)
+	}
+	if entries, err := fsys.ReadDir("."); err != nil || len(entries) != 1 || entries[0].Name() != "dir" {
+		t.Fatalf("Directory entries mismatch: got %v: %v", entries, err)
+	}
+	if err = fsys.Remove("dir"); err != nil {
+		t.Fatalf("Failed to remove directory: %v", err)
+	}
+}
+
+var tamperTests = []func(b []byte, off int) []byte{
+	func(b []byte, _ int) []byte { // Truncate at chunk boundary
+		return b[:len(b)-(10+tagSize)]
+	},
+	func(b []byte, _ int) []byte { // Truncate within chunk
+		return b[:len(b)-5]
+	},
+	func(b []byte, off int) []byte { // Swap first two chunks
+		const n = testChunkSize + tagSize
+		c := bytes.Clone(b)
+		copy(c[off:], b[off+n:off+2*n])
+		copy(c[off+n:], b[off:off+n])
+		return c
+	},
+	func(b []byte, off int) []byte { // Flip bit
+		c := bytes.Clone(b)
+		c[off+testChunkSize] ^= 1
+		return c
+	},
+	func(b []byte, _ int) []byte { // Modify header
+		c := bytes.Clone(b)
+		c[5] ^= 1
+		return c
+	},
+}
+
+func TestFS_Symlink(t *testing.T) {
+	t.Parallel()
+
+	dir, outside := t.TempDir(), t.TempDir()
+	fsys := newTestFS(t, dir, newTestClient(t))
+	if err := fsys.Mkdir("dir", 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	w, err := fsys.Create("dir/file", 0o644)
+	if err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+
+	// Replace the directory with a symlink to a directory
+	// outside the FS while the file is being written.
+	p, err := fsys.resolve(context.Background(), "dir")
+	if err != nil {
+		t.Fatalf("Failed to resolve directory: %v", err)
+	}
+	p = filepath.Join(dir, p)
+	if err = os.Rename(p, filepath.Join(outside, "dir")); err != nil {
+		t.Fatalf("Failed to move directory: %v", err)
+	}
+	if err = os.Symlink(filepath.Join(outside, "dir"), p); err != nil {
+		t.Fatalf("Failed to create symlink: %v", err)
+	}
+
+	if err = w.Close(); err == nil {
+		t.Fatal("Renamed file through symlink to outside directory")
+	}
+	entries, err := os.ReadDir(filepath.Join(outside, "dir"))
+	if err != nil {
+		t.Fatalf("Failed to read outside directory: %v", err)
+	}
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), ".") {
+			t.Fatalf("File has been renamed within outside directory: %s", entry.Name())
+		}
+	}
+}
+
+func newTestFS(t *testing.T, dir string, client *kms.Client) *FS {
+	fsys, err := New(context.Background(), &Config{
+		Dir:       dir,
+		Client:    client,
+		Enclave:   "storage",
+		Key:       "fs-key",
+		ChunkSize: testChunkSize,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create FS: %v", err)
+	}
+	t.Cleanup(func() { fsys.Close() })
+	return fsys
+}
+
+// newTestClient starts a fake KMS server with the enclave
+// "storage" containing the key "fs-key". It returns a client
+// for the server and shuts the server down once the test ends.
+func newTestClient(t *testing.T) *kms.Client {
+	server := kmstest.NewServer(nil)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := kmstest.NewClient(t, server)
+	if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "storage"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	if err := client.CreateKey(ctx, "storage", &kms.CreateKeyRequest{Name: "fs-key"}); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	return client
+}
@@ -0,0 +1,372 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package encfs
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+)
+
+// An encrypted file consists of a header followed by a sequence
+// of chunks. Each chunk is encrypted with AES-256-GCM using the
+// file's data encryption key (DEK):
+//
+//	header := magic | chunk size | key version | len(key) | key | len(DEK) | DEK
+//	chunk  := AES-256-GCM(DEK, nonce, plaintext, header)
+//	nonce  := chunk index (8 bytes) | 0 0 0 | final (1 byte)
+//
+// The DEK is encrypted by the KMS. Each chunk is bound to the
+// header and its position. The last chunk is marked as final.
+// Hence, reordering, removing or appending chunks is detected.
+const (
+	magic      = "KEF\x01"
+	headerSize = len(magic) + 4 + 4 + 2 + 2 // without key name and DEK
+	tagSize    = 16
+)
+
+// errInvalidChunk is returned when a chunk cannot be decrypted
+// because the file has been modified, truncated or reordered.
+var errInvalidChunk = errors.New("encfs: invalid, truncated or reordered chunk")
+
+// errInvalidHeader is returned when a file does not start
+// with a valid header.
+var errInvalidHeader = errors.New("encfs: invalid file header")
+
+// header is the header of an encrypted file.
+type header struct {
+	ChunkSize  int
+	Key        string
+	Version    int
+	Ciphertext []byte // The DEK encrypted by the KMS
+}
+
+// Size returns the length of the encoded header.
+func (h *header) Size() int { return headerSize + len(h.Key) + len(h.Ciphertext) }
+
+// Marshal returns the encoded header.
+func (h *header) Marshal() []byte {
+	b := make([]byte, 0, h.Size())
+	b = append(b, magic...)
+	b = binary.BigEndian.AppendUint32(b, uint32(h.ChunkSize))
+	b = binary.BigEndian.AppendUint32(b, uint32(h.Version))
+	b = binary.BigEndian.AppendUint16(b, uint16(len(h.Key)))
+	b = append(b, h.Key...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(h.Ciphertext)))
+	return append(b, h.Ciphertext...)
+}
+
+// readHeader reads and parses a header from r. It returns
+// the header and its encoded representation.
+func readHeader(r io.Reader) (*header, []byte, error) {
+	b := make([]byte, len(magic)+4+4+2, headerSize+256)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, nil, readErr(err)
+	}
+	if string(b[:len(magic)]) != magic {
+		return nil, nil, errInvalidHeader
+	}
+
+	h := &header{
+		ChunkSize: int(binary.BigEndian.Uint32(b[4:])),
+		Version:   int(binary.BigEndian.Uint32(b[8:])),
+	}
+	if h.ChunkSize <= 0 || h.ChunkSize > MaxChunkSize {
+		return nil, nil, errInvalidHeader
+	}
+
+	n := int(binary.BigEndian.Uint16(b[12:]))
+	b = append(b, make([]byte, n+2)...)
+	if _, err := io.ReadFull(r, b[len(b)-n-2:]); err != nil {
+		return nil, nil, readErr(err)
+	}
+	h.Key = string(b[len(b)-n-2 : len(b)-2])
+
+	n = int(binary.BigEndian.Uint16(b[len(b)-2:]))
+	b = append(b, make([]byte, n)...)
+	if _, err := io.ReadFull(r, b[len(b)-n:]); err != nil {
+		return nil, nil, readErr(err)
+	}
+	h.Ciphertext = b[len(b)-n:]
+	return h, b, nil
+}
+
+// readErr converts unexpected EOF errors into errInvalidHeader.
+func readErr(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return errInvalidHeader
+	}
+	return err
+}
+
+// chunkNonce returns the nonce of the i-th chunk.
+func chunkNonce(i int64, final bool) []byte {
+	var nonce [12]byte
+	binary.BigEndian.PutUint64(nonce[:], uint64(i))
+	if final {
+		nonce[11] = 1
+	}
+	return nonce[:]
+}
+
+// newAEAD returns a new AES-256-GCM instance using the
+// plaintext DEK.
+func newAEAD(dek []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dek)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// plaintextSize returns the plaintext size and number of chunks
+// of an encrypted file that is size bytes long, including its
+// header.
+func plaintextSize(h *header, size int64) (int64, int64, error) {
+	size -= int64(h.Size())
+
+	sealedSize := int64(h.ChunkSize + tagSize)
+	chunks, rem := size/sealedSize, size%sealedSize
+	switch {
+	case size < tagSize: // Even empty files contain one chunk
+		return 0, 0, errInvalidChunk
+	case rem == 0:
+		return chunks * int64(h.ChunkSize), chunks, nil
+	case rem < tagSize:
+		return 0, 0, errInvalidChunk
+	default:
+		return chunks*int64(h.ChunkSize) + rem - tagSize, chunks + 1, nil
+	}
+}
+
+// Writer encrypts data written to it and writes the
+// encrypted chunks to a file.
+//
+// A Writer must be closed to write the final chunk.
+// Otherwise, the file is considered truncated.
+type Writer struct {
+	fs   *FS
+	path string // Encrypted path of the file
+	tmp  string // Encrypted path of the temporary file
+
+	file   *os.File
+	aead   cipher.AEAD
+	header []byte
+
+	buf    []byte
+	sealed []byte
+	index  int64
+	err    error
+}
+
+// Write encrypts p in chunks and writes them to the
+// underlying file.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	var n int
+	for len(p) > 0 {
+		// Only seal a full chunk once more data arrives since
+		// the last chunk must be marked as final.
+		if len(w.buf) == cap(w.buf) {
+			if w.err = w.writeChunk(false); w.err != nil {
+				return n, w.err
+			}
+		}
+		m := min(len(p), cap(w.buf)-len(w.buf))
+		w.buf = append(w.buf, p[:m]...)
+		p = p[m:]
+		n += m
+	}
+	return n, nil
+}
+
+// Close writes the final chunk, closes the underlying
+// file and replaces the named file with it. If any write
+// has failed, Close removes the underlying file instead.
+func (w *Writer) Close() error {
+	if w.err == fs.ErrClosed { // Only set by Close itself, not by a failed write
+		return w.err
+	}
+	if w.err == nil {
+		w.err = w.writeChunk(true)
+	}
+	if w.err == nil {
+		w.err = w.file.Sync()
+	}
+	if err := w.file.Close(); w.err == nil {
+		w.err = err
+	}
+	if w.err == nil {
+		w.err = w.fs.rename(w.tmp, w.path)
+	}
+	if w.err != nil {
+		w.fs.root.Remove(w.tmp)
+	}
+
+	err := w.err
+	w.err = fs.ErrClosed
+	return err
+}
+
+func (w *Writer) writeChunk(final bool) error {
+	w.sealed = w.aead.Seal(w.sealed[:0], chunkNonce(w.index, final), w.buf, w.header)
+	if _, err := w.file.Write(w.sealed); err != nil {
+		return err
+	}
+	clear(w.buf)
+	w.buf = w.buf[:0]
+	w.index++
+	return nil
+}
+
+// File is an encrypted file opened for reading. It supports
+// random-access reads and can be used concurrently, except for
+// Read and Seek.
+type File struct {
+	file   *os.File
+	info   *fileInfo
+	aead   cipher.AEAD
+	header []byte
+
+	chunkSize int64
+	chunks    int64
+	offset    int64
+
+	mu     sync.Mutex
+	cached int64 // Index of the chunk in buf, or -1
+	buf    []byte
+	sealed []byte
+}
+
+var ( // compiler checks
+	_ fs.File     = (*File)(nil)
+	_ io.ReaderAt = (*File)(nil)
+	_ io.Seeker   = (*File)(nil)
+)
+
+// Stat returns the FileInfo of the plaintext file.
+func (f *File) Stat() (fs.FileInfo, error) { return f.info, nil }
+
+// Read reads up to len(p) decrypted bytes into p.
+func (f *File) Read(p []byte) (int, error) {
+	n, err := f.ReadAt(p, f.offset)
+	f.offset += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+// ReadAt reads len(p) decrypted bytes into p starting at
+// offset off. It only decrypts the chunks containing the
+// requested range.
+func (f *File) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, &fs.PathError{Op: "read", Path: f.info.name, Err: fs.ErrInvalid}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var n int
+	for len(p) > 0 {
+		if off >= f.info.size {
+			return n, io.EOF
+		}
+		chunk, err := f.readChunk(off / f.chunkSize)
+		if err != nil {
+			return n, err
+		}
+		m := copy(p, chunk[off%f.chunkSize:])
+		p = p[m:]
+		off += int64(m)
+		n += m
+	}
+	return n, nil
+}
+
+// Seek sets the offset for the next Read.
+func (f *File) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.info.size
+	default:
+		return 0, &fs.PathError{Op: "seek", Path: f.info.name, Err: fs.ErrInvalid}
+	}
+	if offset < 0 {
+		return 0, &fs.PathError{Op: "seek", Path: f.info.name, Err: fs.ErrInvalid}
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+// Close closes the file.
+func (f *File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	clear(f.buf)
+	f.cached = -1
+	return f.file.Close()
+}
+
+// readChunk returns the decrypted i-th chunk. It must be
+// called while holding f.mu.
+func (f *File) readChunk(i int64) ([]byte, error) {
+	if f.cached == i {
+		return f.buf, nil
+	}
+
+	sealedSize := f.chunkSize + tagSize
+	size := sealedSize
+	if i == f.chunks-1 {
+		size = f.info.size - i*f.chunkSize + tagSize
+	}
+	f.sealed = f.sealed[:size]
+	if _, err := f.file.ReadAt(f.sealed, int64(len(f.header))+i*sealedSize); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errInvalidChunk
+		}
+		return nil, err
+	}
+
+	buf, err := f.aead.Open(f.buf[:0], chunkNonce(i, i == f.chunks-1), f.sealed, f.header)
+	if err != nil {
+		f.cached = -1
+		return nil, errInvalidChunk
+	}
+	f.buf, f.cached = buf, i
+	return f.buf, nil
+}
+
+// fileInfo is the fs.FileInfo of a plaintext file
+// or directory.
+type fileInfo struct {
+	name    string
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+var _ fs.FileInfo = (*fileInfo)(nil) // compiler check
+
+func (fi *fileInfo) Name() string       { return fi.name }
+func (fi *fileInfo) Size() int64        { return fi.size }
+func (fi *fileInfo) Mode() fs.FileMode  { return fi.mode }
+func (fi *fileInfo) ModTime() time.Time { return fi.modTime }
+func (fi *fileInfo) IsDir() bool        { return fi.mode.IsDir() }
+func (fi *fileInfo) Sys() any           { return nil }
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package encfs
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+)
+
+// maxNameLen is the max. length of a plaintext file name. The
+// encrypted and base64 encoded name of a 175 byte name is 255
+// bytes long, the limit of most filesystems.
+const maxNameLen = 175
+
+// ErrNameTooLong is returned when a file name exceeds the
+// max. length of encrypted file names.
+var ErrNameTooLong = errors.New("encfs: file name too long")
+
+// errInvalidName is returned when an encrypted file name
+// is malformed or has been modified.
+var errInvalidName = errors.New("encfs: invalid encrypted file name")
+
+// nameCipher encrypts file names deterministically, such that
+// a file can be located by its plaintext name.
+//
+// It implements the SIV construction: the IV is the HMAC-SHA256
+// of the name, truncated to 16 bytes, and the name is encrypted
+// with AES-256-CTR using this IV. Equal names within the same
+// directory produce equal ciphertexts. Otherwise, the ciphertext
+// reveals nothing but the length of the name.
+type nameCipher struct {
+	block  cipher.Block
+	macKey []byte
+}
+
+// newNameCipher returns a nameCipher using keys derived
+// from the directory's data encryption key.
+func newNameCipher(dek []byte) (*nameCipher, error) {
+	key, err := hkdf.Key(sha256.New, dek, nil, "encfs file name encryption", 64)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key[:32])
+	if err != nil {
+		return nil, err
+	}
+	return &nameCipher{
+		block:  block,
+		macKey: key[32:],
+	}, nil
+}
+
+// Encrypt returns the encrypted and base64 encoded name.
+func (c *nameCipher) Encrypt(name string) (string, error) {
+	if len(name) > maxNameLen {
+		return "", ErrNameTooLong
+	}
+
+	iv := c.iv(name)
+	b := make([]byte, aes.BlockSize+len(name))
+	copy(b, iv)
+	cipher.NewCTR(c.block, iv).XORKeyStream(b[aes.BlockSize:], []byte(name))
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Decrypt returns the plaintext name of the encrypted name.
+func (c *nameCipher) Decrypt(name string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(name)
+	if err != nil || len(b) < aes.BlockSize {
+		return "", errInvalidName
+	}
+
+	iv, plaintext := b[:aes.BlockSize], b[aes.BlockSize:]
+	cipher.NewCTR(c.block, iv).XORKeyStream(plaintext, plaintext)
+	if subtle.ConstantTimeCompare(iv, c.iv(string(plaintext))) != 1 {
+		return "", errInvalidName
+	}
+	return string(plaintext), nil
+}
+
+// iv returns the synthetic IV of name.
+func (c *nameCipher) iv(name string) []byte {
+	mac := hmac.New(sha256.New, c.macKey)
+	mac.Write([]byte(name))
+	return mac.Sum(nil)[:aes.BlockSize]
+}
@@ -12,8 +12,10 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"aead.dev/mem"
+	"github.com/openstor/kms-go/kms/cmds"
 	"github.com/openstor/kms-go/kms/internal/headers"
 	pb "github.com/openstor/kms-go/kms/protobuf"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -28,6 +30,10 @@ var (
 	// may try to perform an admin operation without admin permissions.
 	ErrPermission = Error{http.StatusForbidden, "access denied: insufficient permissions"}
 
+	// ErrNodeNotFound is returned when trying to operate on a KMS
+	// server node that is not part of the cluster.
+	ErrNodeNotFound = Error{http.StatusNotFound, "node does not exist"}
+
 	// ErrEnclaveExists is returned when trying to create an enclave
 	// that already exists.
 	ErrEnclaveExists = Error{http.StatusConflict, "enclave already exists"}
@@ -45,6 +51,15 @@ var (
 	// that does not exist.
 	ErrKeyNotFound = Error{http.StatusNotFound, "key does not exist"}
 
+	// ErrKeyVersionDecryptOnly is returned when trying to encrypt, generate
+	// a data key or compute a MAC with a key version that can only be used
+	// for decryption.
+	ErrKeyVersionDecryptOnly = Error{http.StatusConflict, "key version is decrypt-only"}
+
+	// ErrKeyVersionDisabled is returned when trying to use a key version
+	// that has been disabled.
+	ErrKeyVersionDisabled = Error{http.StatusConflict, "key version is disabled"}
+
 	// ErrPolicyNotFound is returned when trying to fetch or delete a policy
 	// that does not exist.
 	ErrPolicyNotFound = Error{http.StatusNotFound, "policy does not exist"}
@@ -53,6 +68,19 @@ var (
 	// that does not exist.
 	ErrIdentityNotFound = Error{http.StatusNotFound, "identity does not exist"}
 
+	// ErrSecretExists is returned when trying to create a secret in an
+	// enclave that already contains a secret with the same name.
+	ErrSecretExists = Error{http.StatusConflict, "secret already exists"}
+
+	// ErrSecretNotFound is returned when trying to access or delete a
+	// secret or secret version that does not exist.
+	ErrSecretNotFound = Error{http.StatusNotFound, "secret does not exist"}
+
+	// ErrDryRunNotSupported is returned when a KMS server responds to
+	// a dry-run request without confirming that it has not executed
+	// the request's commands.
+	ErrDryRunNotSupported = Error{http.StatusNotImplemented, "dry-run is not supported"}
+
 	// ErrDecrypt is returned when trying to decrypt an invalid or modified
 	// ciphertext or when the wrong key is used for decryption.
 	ErrDecrypt = Error{http.StatusBadRequest, "invalid ciphertext"}
@@ -153,6 +181,80 @@ func UnwrapHostErrors(err error) []*HostError {
 	}
 }
 
+// OpError describes a failed request sent by a Client method.
+// It records all attempts made by the client's load balancer.
+//
+// Client methods that send KMS commands return a *HostError for
+// the KMS server that handled the request last. The OpError of
+// the request can be retrieved from it using errors.As:
+//
+//	var opErr *kms.OpError
+//	if errors.As(err, &opErr) {
+//		for _, a := range opErr.Attempts {
+//			log.Printf("%s: %v", a.Host, a.Err)
+//		}
+//	}
+//
+// Errors that occur before a request is sent, for example when a
+// request cannot be encoded, carry no OpError.
+type OpError struct {
+	Op      string       // The Client method. For example, "CreateKey"
+	Cmd     cmds.Command // The (first) KMS command of the request
+	Enclave string       // The enclave, if any, the command operated within
+	Batch   int          // The number of commands sent in the request
+
+	// Attempts contains all attempts to send the request in the
+	// order they were made. If the client load balancer retried
+	// the request on other KMS servers, Attempts contains more
+	// than one entry.
+	Attempts []Attempt
+
+	Err error // The underlying error. For example, ErrKeyNotFound
+}
+
+// Attempt describes a single attempt to send a request
+// to a KMS server.
+type Attempt struct {
+	Host      string        // The KMS server the request has been sent to
+	Err       error         // The error, if any, the attempt failed with
+	Duration  time.Duration // How long the attempt took
+	Suspended bool          // Whether the host got suspended due to Err
+}
+
+var _ net.Error = (*OpError)(nil) // compiler check
+
+// Error returns the underlying error message prefixed by the
+// operation. If the request has been sent multiple times, it
+// includes the number of attempts.
+func (e *OpError) Error() string {
+	if len(e.Attempts) > 1 {
+		return fmt.Sprintf("kms: %s: %s (%d attempts)", e.Op, e.Err.Error(), len(e.Attempts))
+	}
+	return fmt.Sprintf("kms: %s: %s", e.Op, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *OpError) Unwrap() error { return e.Err }
+
+// Timeout reports whether the error is caused by a timeout.
+func (e *OpError) Timeout() bool {
+	t, ok := e.Err.(interface {
+		Timeout() bool
+	})
+	return ok && t.Timeout()
+}
+
+// Temporary reports whether the error is temporary.
+//
+// Deprecated: Temporary errors are not well-defined.
+// It is only there to satisfy the net.Error interface.
+func (e *OpError) Temporary() bool {
+	t, ok := e.Err.(interface {
+		Temporary() bool
+	})
+	return ok && t.Temporary()
+}
+
 func hostError(host string, err error) error {
 	if err == nil {
 		return nil
@@ -168,6 +270,8 @@ func hostError(host string, err error) error {
 type HostError struct {
 	Host string // The host for which an operation failed
 	Err  error  // The underlying error
+
+	op *OpError // The failed request, if any
 }
 
 var _ net.Error = (*HostError)(nil) // compiler check
@@ -183,6 +287,17 @@ func (e *HostError) Error() string {
 // Unwrap returns the underlying error.
 func (e *HostError) Unwrap() error { return e.Err }
 
+// As sets target to the OpError of the failed request if target
+// is of type **OpError and the HostError has been returned by a
+// Client method that has sent the request.
+func (e *HostError) As(target any) bool {
+	if op, ok := target.(**OpError); ok && e.op != nil {
+		*op = e.op
+		return true
+	}
+	return false
+}
+
 // Timeout reports whether the error s caused by a timeout.
 func (e *HostError) Timeout() bool {
 	t, ok := e.Err.(interface {
@@ -201,3 +316,25 @@ func (e *HostError) Temporary() bool {
 	})
 	return ok && t.Temporary()
 }
+
+// ClusterEditError is returned when editing the cluster definition of
+// multiple KMS servers, one after another, fails. The cluster definition
+// of all servers in Edited has been changed already while the cluster
+// definition of Host and all remaining servers has not.
+type ClusterEditError struct {
+	Edited []string // The servers whose cluster definition has been edited
+	Host   string   // The server whose cluster definition could not be edited
+	Err    error    // The underlying error
+}
+
+// Error returns the underlying error message including
+// the servers that have been edited already, if any.
+func (e *ClusterEditError) Error() string {
+	if len(e.Edited) == 0 {
+		return fmt.Sprintf("kms: failed to edit cluster definition of %q: %s", e.Host, e.Err.Error())
+	}
+	return fmt.Sprintf("kms: failed to edit cluster definition of %q after editing %q: %s", e.Host, e.Edited, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e *ClusterEditError) Unwrap() error { return e.Err }
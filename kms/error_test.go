@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/internal/headers"
+)
+
+func TestOpError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headers.ContentType, headers.ContentTypeJSON)
+		w.WriteHeader(ErrKeyNotFound.Code)
+		w.Write([]byte(`{"message":"` + ErrKeyNotFound.Err + `"}`))
+	}))
+	defer server.Close()
+
+	live := server.Listener.Addr().String()
+	dead1, dead2 := closedAddr(t), closedAddr(t)
+
+	for i, test := range opErrorTests {
+		var endpoints []string
+		for _, e := range test.Endpoints {
+			switch e {
+			case "live":
+				endpoints = append(endpoints, live)
+			case "dead-1":
+				endpoints = append(endpoints, dead1)
+			case "dead-2":
+				endpoints = append(endpoints, dead2)
+			}
+		}
+
+		// The load balancer picks the first host at random. Retry
+		// with a new client until the hosts have been tried in the
+		// expected order.
+		var (
+			err   error
+			opErr *OpError
+		)
+		for range 64 {
+			client := newTestClient(t, server, endpoints)
+
+			_, err = client.Encrypt(context.Background(), "my-enclave",
+				&EncryptRequest{Name: "my-key", Plaintext: []byte("a")},
+				&EncryptRequest{Name: "my-key", Plaintext: []byte("b")},
+			)
+			if _, ok := err.(*HostError); !ok {
+				t.Fatalf("Test %d: error is not a HostError: %v", i, err)
+			}
+			if !errors.As(err, &opErr) {
+				t.Fatalf("Test %d: error is not an OpError: %v", i, err)
+			}
+			if len(opErr.Attempts) == test.Attempts {
+				break
+			}
+		}
+		if opErr.Op != "Encrypt" || opErr.Cmd != cmds.KeyEncrypt || opErr.Enclave != "my-enclave" || opErr.Batch != 2 {
+			t.Fatalf("Test %d: invalid OpError: got '%s %v %s %d'", i, opErr.Op, opErr.Cmd, opErr.Enclave, opErr.Batch)
+		}
+		if len(opErr.Attempts) != test.Attempts {
+			t.Fatalf("Test %d: attempts mismatch: got '%d' - want '%d'", i, len(opErr.Attempts), test.Attempts)
+		}
+		for j, a := range opErr.Attempts {
+			if a.Err == nil {
+				t.Fatalf("Test %d: attempt %d has no error", i, j)
+			}
+			if suspended := a.Host != live; a.Suspended != suspended {
+				t.Fatalf("Test %d: attempt %d: suspended mismatch: got '%v' - want '%v'", i, j, a.Suspended, suspended)
+			}
+		}
+		if hostErr := AsHostError(err); hostErr == nil || hostErr.Host != opErr.Attempts[len(opErr.Attempts)-1].Host {
+			t.Fatalf("Test %d: HostError does not name the last host: %v", i, hostErr)
+		}
+		if test.Err != nil && !errors.Is(err, test.Err) {
+			t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, test.Err)
+		}
+	}
+}
+
+var opErrorTests = []struct {
+	Endpoints []string
+	Attempts  int
+	Err       error
+}{
+	{Endpoints: []string{"live"}, Attempts: 1, Err: ErrKeyNotFound},
+	{Endpoints: []string{"dead-1"}, Attempts: 1},
+	{Endpoints: []string{"dead-1", "dead-2"}, Attempts: 2},
+	{Endpoints: []string{"dead-1", "live"}, Attempts: 2, Err: ErrKeyNotFound},
+}
+
+// newTestClient returns a new Client that sends requests to
+// the given endpoints and trusts the server's certificate.
+func newTestClient(t *testing.T, server *httptest.Server, endpoints []string) *Client {
+	client, err := NewClient(newTestConfig(t, server, endpoints))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+// newTestConfig returns a Client config that trusts
+// the server's certificate.
+func newTestConfig(t *testing.T, server *httptest.Server, endpoints []string) *Config {
+	key, err := mtls.ParsePrivateKey("k1:d7cY_5k8HbBGkZpoy2hGmvkxg83QDBXsA_nFXDfTk2E")
+	if err != nil {
+		t.Fatalf("Failed to parse API key: %v", err)
+	}
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+	return &Config{
+		Endpoints: endpoints,
+		APIKey:    key,
+		TLS:       &tls.Config{RootCAs: rootCAs},
+	}
+}
+
+// closedAddr returns a local address no server listens on.
+func closedAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	return addr
+}
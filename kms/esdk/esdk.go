@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package esdk implements the AWS Encryption SDK message format
+// using the KMS for wrapping data keys.
+//
+// Messages produced by Encrypt can be decrypted by any AWS
+// Encryption SDK implementation that has a keyring for the
+// "openstor-kms" key provider, and vice versa:
+//
+//	keyring := &esdk.KMSKeyring{
+//		Client:  client,
+//		Enclave: "storage",
+//		Key:     "file-key",
+//	}
+//	message, err := esdk.Encrypt(ctx, keyring, plaintext, &esdk.Options{
+//		Context: map[string]string{"bucket": "reports"},
+//	})
+//
+// A RawAESKeyring wraps data keys with a static AES key, like
+// the raw AES keyring of the AWS Encryption SDK. For example,
+// to decrypt messages produced by other tooling.
+//
+// Only the signature-less AES-256-GCM algorithm suites are
+// supported. Messages are always encrypted in frames but
+// Decrypt also decrypts non-framed messages.
+package esdk
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"io"
+	"maps"
+	"math"
+)
+
+// DefaultFrameLength is the default length of message frames.
+const DefaultFrameLength = 4096
+
+// Options contains options for encrypting messages.
+type Options struct {
+	// Suite is the algorithm suite. If zero, defaults to
+	// AES256GCMHKDFSHA512Commit.
+	Suite Suite
+
+	// FrameLength is the length of plaintext frames. If zero,
+	// defaults to DefaultFrameLength.
+	FrameLength uint32
+
+	// Context is the encryption context. It is not encrypted
+	// but authenticated and stored in the message header. Keys
+	// must not start with "aws-crypto-".
+	Context map[string]string
+}
+
+// Encrypt encrypts the plaintext and returns the message. The
+// keyring generates the data key and adds at least one encrypted
+// data key to the message header.
+func Encrypt(ctx context.Context, keyring Keyring, plaintext []byte, opts *Options) ([]byte, error) {
+	return encrypt(ctx, rand.Reader, keyring, plaintext, opts)
+}
+
+// Decrypt decrypts the message and returns the plaintext
+// and the message's encryption context.
+//
+// The keyring must be able to decrypt one of the message's
+// encrypted data keys. Callers should verify that the returned
+// encryption context contains the expected key-value pairs.
+func Decrypt(ctx context.Context, keyring Keyring, message []byte) ([]byte, map[string]string, error) {
+	h, body, b, err := parseHeader(message)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := &DecryptionMaterials{
+		Suite:   h.Suite,
+		Context: maps.Clone(h.Context),
+	}
+	if err = keyring.OnDecrypt(ctx, m, h.EncryptedDataKeys); err != nil {
+		return nil, nil, err
+	}
+	if len(m.DataKey) != dataKeySize {
+		return nil, nil, errors.New("esdk: invalid data key length")
+	}
+	defer clear(m.DataKey)
+
+	key, commitment, err := h.Suite.deriveKey(m.DataKey, h.MessageID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if commitment != nil && subtle.ConstantTimeCompare(commitment, h.Commitment) != 1 {
+		return nil, nil, errors.New("esdk: key commitment mismatch")
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iv := h.IV
+	if iv == nil {
+		iv = make([]byte, ivSize)
+	}
+	if _, err = aead.Open(nil, iv, h.Tag, body); err != nil {
+		return nil, nil, errors.New("esdk: header authentication failed")
+	}
+
+	var plaintext []byte
+	if h.ContentType == contentNonFramed {
+		plaintext, b, err = decryptNonFramed(aead, h, b)
+	} else {
+		plaintext, b, err = decryptFramed(aead, h, b)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(b) != 0 {
+		return nil, nil, errMalformed // Signature-less messages have no footer
+	}
+	return plaintext, h.Context, nil
+}
+
+func encrypt(ctx context.Context, random io.Reader, keyring Keyring, plaintext []byte, opts *Options) ([]byte, error) {
+	suite, frameLength := AES256GCMHKDFSHA512Commit, uint32(DefaultFrameLength)
+	var encContext map[string]string
+	if opts != nil {
+		if opts.Suite != 0 {
+			suite = opts.Suite
+		}
+		if opts.FrameLength != 0 {
+			frameLength = opts.FrameLength
+		}
+		encContext = opts.Context
+	}
+	if !suite.valid() {
+		return nil, errors.New("esdk: algorithm suite '" + suite.String() + "' is not supported")
+	}
+	if err := verifyContext(encContext); err != nil {
+		return nil, err
+	}
+	if frames := uint64(len(plaintext))/uint64(frameLength) + 1; frames >= finalFrameMarker {
+		return nil, errors.New("esdk: plaintext too large")
+	}
+
+	messageID := make([]byte, suite.messageIDSize())
+	if _, err := io.ReadFull(random, messageID); err != nil {
+		return nil, err
+	}
+
+	m := &EncryptionMaterials{
+		Suite:   suite,
+		Context: maps.Clone(encContext),
+	}
+	if err := keyring.OnEncrypt(ctx, m); err != nil {
+		return nil, err
+	}
+	if len(m.DataKey) != dataKeySize {
+		return nil, errors.New("esdk: invalid data key length")
+	}
+	defer clear(m.DataKey)
+
+	key, commitment, err := suite.deriveKey(m.DataKey, messageID)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &header{
+		Suite:             suite,
+		MessageID:         messageID,
+		Context:           encContext,
+		EncryptedDataKeys: m.EncryptedDataKeys,
+		ContentType:       contentFramed,
+		FrameLength:       frameLength,
+		Commitment:        commitment,
+	}
+	body, err := h.MarshalBody()
+	if err != nil {
+		return nil, err
+	}
+	if suite.version() == 1 {
+		h.IV = make([]byte, ivSize)
+	}
+	h.Tag = aead.Seal(nil, make([]byte, ivSize), nil, body)
+
+	message := append(body, h.MarshalAuth()...)
+	return encryptFramed(message, aead, h, plaintext), nil
+}
+
+// encryptFramed appends the encrypted frames of plaintext to b.
+// The last frame is a final frame, even if it is full. An empty
+// plaintext produces an empty final frame.
+func encryptFramed(b []byte, aead cipher.AEAD, h *header, plaintext []byte) []byte {
+	frameLength := int(h.FrameLength)
+	for seq := uint32(1); ; seq++ {
+		if len(plaintext) > frameLength {
+			iv := frameIV(seq)
+			b = binary.BigEndian.AppendUint32(b, seq)
+			b = append(b, iv...)
+			b = aead.Seal(b, iv, plaintext[:frameLength], bodyAAD(h.MessageID, aadFrame, seq, uint64(frameLength)))
+			plaintext = plaintext[frameLength:]
+			continue
+		}
+
+		iv := frameIV(seq)
+		b = binary.BigEndian.AppendUint32(b, finalFrameMarker)
+		b = binary.BigEndian.AppendUint32(b, seq)
+		b = append(b, iv...)
+		b = binary.BigEndian.AppendUint32(b, uint32(len(plaintext)))
+		return aead.Seal(b, iv, plaintext, bodyAAD(h.MessageID, aadFinalFrame, seq, uint64(len(plaintext))))
+	}
+}
+
+// decryptFramed decrypts all frames at the start of b and
+// returns the plaintext and remaining bytes.
+func decryptFramed(aead cipher.AEAD, h *header, b []byte) ([]byte, []byte, error) {
+	d := decoder{b: b}
+
+	var plaintext []byte
+	for seq := uint32(1); ; seq++ {
+		n := d.Uint32()
+		final := n == finalFrameMarker
+		if final {
+			n = d.Uint32()
+		}
+		if d.err != nil {
+			return nil, nil, d.err
+		}
+		if n != seq {
+			return nil, nil, errors.New("esdk: invalid frame sequence number")
+		}
+
+		iv := d.Bytes(ivSize)
+		length := h.FrameLength
+		if final {
+			length = d.Uint32()
+			if length > h.FrameLength {
+				return nil, nil, errMalformed
+			}
+		}
+		ciphertext := d.Bytes(int(length) + tagSize)
+		if d.err != nil {
+			return nil, nil, d.err
+		}
+		if subtle.ConstantTimeCompare(iv, frameIV(seq)) != 1 {
+			return nil, nil, errors.New("esdk: invalid frame IV")
+		}
+
+		content := aadFrame
+		if final {
+			content = aadFinalFrame
+		}
+		var err error
+		plaintext, err = aead.Open(plaintext, iv, ciphertext, bodyAAD(h.MessageID, content, seq, uint64(length)))
+		if err != nil {
+			return nil, nil, errors.New("esdk: frame authentication failed")
+		}
+		if final {
+			return plaintext, d.b, nil
+		}
+		if seq == finalFrameMarker-1 {
+			return nil, nil, errMalformed
+		}
+	}
+}
+
+// decryptNonFramed decrypts the non-framed body at the start
+// of b and returns the plaintext and remaining bytes.
+func decryptNonFramed(aead cipher.AEAD, h *header, b []byte) ([]byte, []byte, error) {
+	d := decoder{b: b}
+
+	iv := d.Bytes(ivSize)
+	length := d.Uint64()
+	if d.err == nil && length > math.MaxInt-tagSize {
+		return nil, nil, errMalformed
+	}
+	ciphertext := d.Bytes(int(length) + tagSize)
+	if d.err != nil {
+		return nil, nil, d.err
+	}
+
+	plaintext, err := aead.Open(nil, iv, ciphertext, bodyAAD(h.MessageID, aadSingleBlock, 1, length))
+	if err != nil {
+		return nil, nil, errors.New("esdk: message authentication failed")
+	}
+	return plaintext, d.b, nil
+}
+
+// newAEAD returns a new AES-256-GCM instance.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,411 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package esdk
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/kmstest"
+)
+
+// testdata/vectors.json contains messages encrypted with fixed data
+// keys and message IDs. Messages with a frame length of 0 are
+// non-framed. They are only decrypted since Encrypt always
+// produces framed messages.
+func TestVectors(t *testing.T) {
+	t.Parallel()
+
+	vectors := readVectors(t, "testdata/vectors.json")
+	for i, v := range vectors {
+		keyring := &staticKeyring{DataKey: v.DataKey, EncryptedDataKey: v.EncryptedDataKey}
+
+		plaintext, encContext, err := Decrypt(context.Background(), keyring, v.Message)
+		if err != nil {
+			t.Fatalf("Test %d: failed to decrypt message: %v", i, err)
+		}
+		if !bytes.Equal(plaintext, v.Plaintext) {
+			t.Fatalf("Test %d: plaintext mismatch: got '%x' - want '%x'", i, plaintext, v.Plaintext)
+		}
+		if !maps.Equal(encContext, v.Context) && len(encContext)+len(v.Context) > 0 {
+			t.Fatalf("Test %d: encryption context mismatch: got '%v' - want '%v'", i, encContext, v.Context)
+		}
+		if !v.Framed {
+			continue
+		}
+
+		message, err := encrypt(context.Background(), bytes.NewReader(v.MessageID), keyring, v.Plaintext, &Options{
+			Suite:       v.Suite,
+			FrameLength: v.FrameLength,
+			Context:     v.Context,
+		})
+		if err != nil {
+			t.Fatalf("Test %d: failed to encrypt message: %v", i, err)
+		}
+		if !bytes.Equal(message, v.Message) {
+			t.Fatalf("Test %d: message mismatch:\ngot  '%s'\nwant '%s'", i, base64.StdEncoding.EncodeToString(message), base64.StdEncoding.EncodeToString(v.Message))
+		}
+	}
+}
+
+func TestVectors_Tamper(t *testing.T) {
+	t.Parallel()
+
+	vectors := readVectors(t, "testdata/vectors.json")
+	for i, v := range vectors {
+		keyring := &staticKeyring{DataKey: v.DataKey, EncryptedDataKey: v.EncryptedDataKey}
+		for j := range v.Message {
+			message := bytes.Clone(v.Message)
+			message[j] ^= 0x01
+			if _, _, err := Decrypt(context.Background(), keyring, message); err == nil {
+				t.Fatalf("Test %d: decrypted message with modified byte %d", i, j)
+			}
+		}
+		for j := range len(v.Message) {
+			if _, _, err := Decrypt(context.Background(), keyring, v.Message[:j]); err == nil {
+				t.Fatalf("Test %d: decrypted message truncated to %d bytes", i, j)
+			}
+		}
+		if _, _, err := Decrypt(context.Background(), keyring, append(bytes.Clone(v.Message), 0)); err == nil {
+			t.Fatalf("Test %d: decrypted message with trailing data", i)
+		}
+	}
+}
+
+func TestKMSKeyring(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, "file-key", "other-key")
+	keyring := &KMSKeyring{Client: client, Enclave: "storage", Key: "file-key"}
+	other := &KMSKeyring{Client: client, Enclave: "storage", Key: "other-key"}
+
+	plaintext := make([]byte, 3*DefaultFrameLength+7)
+	rand.Read(plaintext)
+	encContext := map[string]string{"bucket": "reports", "object": "2025.csv"}
+
+	for _, suite := range []Suite{AES256GCM, AES256GCMHKDFSHA256, AES256GCMHKDFSHA512Commit} {
+		message, err := Encrypt(context.Background(), keyring, plaintext, &Options{Suite: suite, Context: encContext})
+		if err != nil {
+			t.Fatalf("Suite %v: failed to encrypt: %v", suite, err)
+		}
+		decrypted, decContext, err := Decrypt(context.Background(), keyring, message)
+		if err != nil {
+			t.Fatalf("Suite %v: failed to decrypt: %v", suite, err)
+		}
+		if !bytes.Equal(decrypted, plaintext) {
+			t.Fatalf("Suite %v: plaintext mismatch", suite)
+		}
+		if !maps.Equal(decContext, encContext) {
+			t.Fatalf("Suite %v: encryption context mismatch: got '%v' - want '%v'", suite, decContext, encContext)
+		}
+		if _, _, err = Decrypt(context.Background(), other, message); err == nil {
+			t.Fatalf("Suite %v: decrypted message with other KMS key", suite)
+		}
+	}
+
+	if _, err := Encrypt(context.Background(), keyring, plaintext, &Options{Context: map[string]string{"aws-crypto-public-key": ""}}); err == nil {
+		t.Fatal("Encrypted message with reserved encryption context key")
+	}
+}
+
+func TestKMSKeyring_Multi(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, "first-key", "second-key")
+	first := &KMSKeyring{Client: client, Enclave: "storage", Key: "first-key"}
+	second := &KMSKeyring{Client: client, Enclave: "storage", Key: "second-key"}
+
+	message, err := Encrypt(context.Background(), multiKeyring{first, second}, []byte("Hello World"), nil)
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+	h, _, _, err := parseHeader(message)
+	if err != nil {
+		t.Fatalf("Failed to parse header: %v", err)
+	}
+	if n := len(h.EncryptedDataKeys); n != 2 {
+		t.Fatalf("Encrypted data key count mismatch: got %d - want %d", n, 2)
+	}
+	for _, keyring := range []Keyring{first, second} {
+		plaintext, _, err := Decrypt(context.Background(), keyring, message)
+		if err != nil {
+			t.Fatalf("Failed to decrypt: %v", err)
+		}
+		if string(plaintext) != "Hello World" {
+			t.Fatalf("Plaintext mismatch: got '%s' - want '%s'", plaintext, "Hello World")
+		}
+	}
+}
+
+func TestRawAESKeyring(t *testing.T) {
+	t.Parallel()
+
+	key := make([]byte, 32)
+	rand.Read(key)
+	keyring := &RawAESKeyring{Namespace: "my-namespace", Name: "my-key", Key: key}
+	encContext := map[string]string{"bucket": "reports"}
+
+	for _, suite := range []Suite{AES256GCM, AES256GCMHKDFSHA256, AES256GCMHKDFSHA512Commit} {
+		message, err := Encrypt(context.Background(), keyring, []byte("Hello World"), &Options{Suite: suite, Context: encContext})
+		if err != nil {
+			t.Fatalf("Suite %v: failed to encrypt: %v", suite, err)
+		}
+		h, _, _, err := parseHeader(message)
+		if err != nil {
+			t.Fatalf("Suite %v: failed to parse header: %v", suite, err)
+		}
+		info := h.EncryptedDataKeys[0].ProviderInfo
+		if want := "my-key\x00\x00\x00\x80\x00\x00\x00\x0c"; len(info) != len(want)+12 || string(info[:len(want)]) != want {
+			t.Fatalf("Suite %v: provider info mismatch: got '%x'", suite, info)
+		}
+
+		plaintext, _, err := Decrypt(context.Background(), keyring, message)
+		if err != nil {
+			t.Fatalf("Suite %v: failed to decrypt: %v", suite, err)
+		}
+		if string(plaintext) != "Hello World" {
+			t.Fatalf("Suite %v: plaintext mismatch: got '%s' - want '%s'", suite, plaintext, "Hello World")
+		}
+		for _, other := range []*RawAESKeyring{
+			{Namespace: "other-namespace", Name: "my-key", Key: key},
+			{Namespace: "my-namespace", Name: "other-key", Key: key},
+			{Namespace: "my-namespace", Name: "my-key", Key: make([]byte, 32)},
+		} {
+			if _, _, err = Decrypt(context.Background(), other, message); err == nil {
+				t.Fatalf("Suite %v: decrypted message with keyring '%s/%s'", suite, other.Namespace, other.Name)
+			}
+		}
+	}
+}
+
+// TestAWSVectors decrypts the messages of the decrypt manifest from
+// the aws-encryption-sdk-test-vectors repository, if extracted to
+// testdata/aws-vectors. Only tests using raw AES keys and supported
+// algorithm suites are run.
+func TestAWSVectors(t *testing.T) {
+	t.Parallel()
+
+	const Dir = "testdata/aws-vectors"
+	if _, err := os.Stat(Dir + "/manifest.json"); errors.Is(err, os.ErrNotExist) {
+		t.Skip("Skipping: " + Dir + " does not exist")
+	}
+	readFile := func(uri string) []byte {
+		b, err := os.ReadFile(Dir + "/" + strings.TrimPrefix(uri, "file://"))
+		if err != nil {
+			t.Fatalf("Failed to read test vector file: %v", err)
+		}
+		return b
+	}
+
+	var manifest struct {
+		Keys  string `json:"keys"`
+		Tests map[string]struct {
+			Plaintext  string `json:"plaintext"`
+			Ciphertext string `json:"ciphertext"`
+			MasterKeys []struct {
+				Type       string `json:"type"`
+				Key        string `json:"key"`
+				ProviderID string `json:"provider-id"`
+				Algorithm  string `json:"encryption-algorithm"`
+			} `json:"master-keys"`
+		} `json:"tests"`
+	}
+	if err := json.Unmarshal(readFile("manifest.json"), &manifest); err != nil {
+		t.Fatalf("Failed to parse manifest: %v", err)
+	}
+	var keys struct {
+		Keys map[string]struct {
+			Type     string `json:"type"`
+			KeyID    string `json:"key-id"`
+			Encoding string `json:"encoding"`
+			Material string `json:"material"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(readFile(manifest.Keys), &keys); err != nil {
+		t.Fatalf("Failed to parse keys: %v", err)
+	}
+
+	var n int
+	for _, name := range slices.Sorted(maps.Keys(manifest.Tests)) {
+		test := manifest.Tests[name]
+		if len(test.MasterKeys) != 1 || test.MasterKeys[0].Type != "raw" || test.MasterKeys[0].Algorithm != "aes" {
+			continue
+		}
+		message := readFile(test.Ciphertext)
+		if h, _, _, err := parseHeader(message); err != nil || !h.Suite.valid() {
+			continue
+		}
+
+		mk := test.MasterKeys[0]
+		key, ok := keys.Keys[mk.Key]
+		if !ok || key.Type != "symmetric" || key.Encoding != "base64" {
+			t.Fatalf("Test %s: unsupported key '%s'", name, mk.Key)
+		}
+		material, err := base64.StdEncoding.DecodeString(key.Material)
+		if err != nil {
+			t.Fatalf("Test %s: failed to decode key '%s': %v", name, mk.Key, err)
+		}
+		keyring := &RawAESKeyring{Namespace: mk.ProviderID, Name: key.KeyID, Key: material}
+
+		plaintext, _, err := Decrypt(context.Background(), keyring, message)
+		if err != nil {
+			t.Fatalf("Test %s: failed to decrypt message: %v", name, err)
+		}
+		if !bytes.Equal(plaintext, readFile(test.Plaintext)) {
+			t.Fatalf("Test %s: plaintext mismatch", name)
+		}
+		n++
+	}
+	if n == 0 {
+		t.Fatal("No test vector uses a raw AES key and a supported algorithm suite")
+	}
+}
+
+type testVector struct {
+	Suite            Suite
+	FrameLength      uint32
+	Framed           bool
+	Context          map[string]string
+	DataKey          []byte
+	EncryptedDataKey EncryptedDataKey
+	MessageID        []byte
+	Plaintext        []byte
+	Message          []byte
+}
+
+func readVectors(t *testing.T, filename string) []testVector {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read test vectors: %v", err)
+	}
+	var raw []struct {
+		Suite        string            `json:"suite"`
+		FrameLength  uint32            `json:"frame_length"`
+		Context      map[string]string `json:"encryption_context"`
+		DataKey      string            `json:"data_key"`
+		ProviderID   string            `json:"provider_id"`
+		ProviderInfo string            `json:"provider_info"`
+		EDK          string            `json:"encrypted_data_key"`
+		MessageID    string            `json:"message_id"`
+		Plaintext    string            `json:"plaintext"`
+		Message      string            `json:"message"`
+	}
+	if err = json.Unmarshal(b, &raw); err != nil {
+		t.Fatalf("Failed to parse test vectors: %v", err)
+	}
+
+	mustDecode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			t.Fatalf("Failed to decode test vector: %v", err)
+		}
+		return b
+	}
+	vectors := make([]testVector, 0, len(raw))
+	for _, r := range raw {
+		suite, err := ParseSuite(r.Suite)
+		if err != nil {
+			t.Fatalf("Failed to parse test vector: %v", err)
+		}
+		message, err := base64.StdEncoding.DecodeString(r.Message)
+		if err != nil {
+			t.Fatalf("Failed to decode test vector: %v", err)
+		}
+		vectors = append(vectors, testVector{
+			Suite:       suite,
+			FrameLength: r.FrameLength,
+			Framed:      r.FrameLength > 0,
+			Context:     r.Context,
+			DataKey:     mustDecode(r.DataKey),
+			EncryptedDataKey: EncryptedDataKey{
+				ProviderID:   r.ProviderID,
+				ProviderInfo: []byte(r.ProviderInfo),
+				Ciphertext:   mustDecode(r.EDK),
+			},
+			MessageID: mustDecode(r.MessageID),
+			Plaintext: mustDecode(r.Plaintext),
+			Message:   message,
+		})
+	}
+	return vectors
+}
+
+// staticKeyring is a Keyring with a fixed data key and
+// encrypted data key.
+type staticKeyring struct {
+	DataKey          []byte
+	EncryptedDataKey EncryptedDataKey
+}
+
+func (k *staticKeyring) OnEncrypt(_ context.Context, m *EncryptionMaterials) error {
+	m.DataKey = bytes.Clone(k.DataKey)
+	m.EncryptedDataKeys = append(m.EncryptedDataKeys, k.EncryptedDataKey)
+	return nil
+}
+
+func (k *staticKeyring) OnDecrypt(_ context.Context, m *DecryptionMaterials, keys []EncryptedDataKey) error {
+	for _, key := range keys {
+		if key.ProviderID == k.EncryptedDataKey.ProviderID && bytes.Equal(key.Ciphertext, k.EncryptedDataKey.Ciphertext) {
+			m.DataKey = bytes.Clone(k.DataKey)
+			return nil
+		}
+	}
+	return errors.New("esdk: no matching encrypted data key")
+}
+
+// multiKeyring encrypts the data key with all keyrings.
+type multiKeyring []Keyring
+
+func (k multiKeyring) OnEncrypt(ctx context.Context, m *EncryptionMaterials) error {
+	for _, keyring := range k {
+		if err := keyring.OnEncrypt(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k multiKeyring) OnDecrypt(ctx context.Context, m *DecryptionMaterials, keys []EncryptedDataKey) error {
+	var errs []error
+	for _, keyring := range k {
+		if err := keyring.OnDecrypt(ctx, m, keys); err == nil {
+			return nil
+		} else {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// newTestClient starts a fake KMS server with the enclave
+// "storage" containing the given keys. It returns a client
+// for the server and shuts the server down once the test ends.
+func newTestClient(t *testing.T, keys ...string) *kms.Client {
+	server := kmstest.NewServer(nil)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := kmstest.NewClient(t, server)
+	if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "storage"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	for _, key := range keys {
+		if err := client.CreateKey(ctx, "storage", &kms.CreateKeyRequest{Name: key}); err != nil {
+			t.Fatalf("Failed to create key '%s': %v", key, err)
+		}
+	}
+	return client
+}
@@ -0,0 +1,322 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package esdk
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Sizes and constants of the message format.
+const (
+	dataKeySize    = 32
+	commitmentSize = 32
+	ivSize         = 12
+	tagSize        = 16
+
+	typeCustomerAED   = 0x80 // Customer authenticated encrypted data, v1 only
+	contentNonFramed  = 0x01
+	contentFramed     = 0x02
+	finalFrameMarker  = 0xFFFFFFFF
+	reservedKeyPrefix = "aws-crypto-"
+)
+
+// Additional authenticated data of message body parts.
+const (
+	aadFrame       = "AWSKMSEncryptionClient Frame"
+	aadFinalFrame  = "AWSKMSEncryptionClient Final Frame"
+	aadSingleBlock = "AWSKMSEncryptionClient Single Block"
+)
+
+// errMalformed is returned when a message cannot be parsed.
+var errMalformed = errors.New("esdk: malformed message")
+
+// header is a message header.
+type header struct {
+	Suite             Suite
+	MessageID         []byte
+	Context           map[string]string
+	EncryptedDataKeys []EncryptedDataKey
+	ContentType       byte
+	FrameLength       uint32
+	Commitment        []byte // Version 2 only
+
+	IV  []byte // Header authentication IV, version 1 only
+	Tag []byte // Header authentication tag
+}
+
+// MarshalBody returns the serialized header without the header
+// authentication. It is authenticated by the header authentication tag.
+func (h *header) MarshalBody() ([]byte, error) {
+	aad, err := marshalContext(h.Context)
+	if err != nil {
+		return nil, err
+	}
+	if len(h.EncryptedDataKeys) == 0 || len(h.EncryptedDataKeys) > math.MaxUint16 {
+		return nil, errors.New("esdk: invalid number of encrypted data keys")
+	}
+
+	var b []byte
+	version := h.Suite.version()
+	b = append(b, version)
+	if version == 1 {
+		b = append(b, typeCustomerAED)
+	}
+	b = binary.BigEndian.AppendUint16(b, uint16(h.Suite))
+	b = append(b, h.MessageID...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(aad)))
+	b = append(b, aad...)
+
+	b = binary.BigEndian.AppendUint16(b, uint16(len(h.EncryptedDataKeys)))
+	for _, key := range h.EncryptedDataKeys {
+		if len(key.ProviderID) > math.MaxUint16 || len(key.ProviderInfo) > math.MaxUint16 || len(key.Ciphertext) > math.MaxUint16 {
+			return nil, errors.New("esdk: encrypted data key too large")
+		}
+		b = appendField(b, []byte(key.ProviderID))
+		b = appendField(b, key.ProviderInfo)
+		b = appendField(b, key.Ciphertext)
+	}
+
+	b = append(b, h.ContentType)
+	if version == 1 {
+		b = append(b, 0, 0, 0, 0) // Reserved
+		b = append(b, ivSize)
+	}
+	b = binary.BigEndian.AppendUint32(b, h.FrameLength)
+	if version == 2 {
+		b = append(b, h.Commitment...)
+	}
+	return b, nil
+}
+
+// MarshalAuth returns the serialized header authentication.
+func (h *header) MarshalAuth() []byte {
+	if h.Suite.version() == 1 {
+		return append(slices.Clip(h.IV), h.Tag...)
+	}
+	return slices.Clone(h.Tag)
+}
+
+// parseHeader parses the header at the start of b. It returns the
+// header, the serialized header body and the remaining bytes.
+func parseHeader(b []byte) (*header, []byte, []byte, error) {
+	d := decoder{b: b}
+
+	h := new(header)
+	version := d.Byte()
+	switch version {
+	case 1:
+		if d.Byte() != typeCustomerAED {
+			return nil, nil, nil, errMalformed
+		}
+	case 2:
+	default:
+		return nil, nil, nil, errMalformed
+	}
+
+	h.Suite = Suite(d.Uint16())
+	if d.err == nil && (!h.Suite.valid() || h.Suite.version() != version) {
+		return nil, nil, nil, errors.New("esdk: algorithm suite '" + h.Suite.String() + "' is not supported")
+	}
+	h.MessageID = d.Bytes(h.Suite.messageIDSize())
+
+	context, err := parseContext(d.Bytes(int(d.Uint16())))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	h.Context = context
+
+	n := int(d.Uint16())
+	if d.err == nil && n == 0 {
+		return nil, nil, nil, errMalformed
+	}
+	for range n {
+		if d.err != nil {
+			break
+		}
+		h.EncryptedDataKeys = append(h.EncryptedDataKeys, EncryptedDataKey{
+			ProviderID:   string(d.Bytes(int(d.Uint16()))),
+			ProviderInfo: d.Bytes(int(d.Uint16())),
+			Ciphertext:   d.Bytes(int(d.Uint16())),
+		})
+	}
+
+	h.ContentType = d.Byte()
+	if version == 1 {
+		d.Bytes(4) // Reserved
+		if d.Byte() != ivSize && d.err == nil {
+			return nil, nil, nil, errMalformed
+		}
+	}
+	h.FrameLength = d.Uint32()
+	if version == 2 {
+		h.Commitment = d.Bytes(commitmentSize)
+	}
+	body := b[:len(b)-len(d.b)]
+
+	if version == 1 {
+		h.IV = d.Bytes(ivSize)
+	}
+	h.Tag = d.Bytes(tagSize)
+	if d.err != nil {
+		return nil, nil, nil, d.err
+	}
+
+	switch h.ContentType {
+	case contentFramed:
+		if h.FrameLength == 0 {
+			return nil, nil, nil, errMalformed
+		}
+	case contentNonFramed:
+		if h.FrameLength != 0 {
+			return nil, nil, nil, errMalformed
+		}
+	default:
+		return nil, nil, nil, errMalformed
+	}
+	return h, body, d.b, nil
+}
+
+// marshalContext returns the serialized encryption context.
+// Key-value pairs are sorted by key. An empty context is
+// serialized as empty byte slice.
+func marshalContext(context map[string]string) ([]byte, error) {
+	if len(context) == 0 {
+		return nil, nil
+	}
+	if len(context) > math.MaxUint16 {
+		return nil, errors.New("esdk: encryption context too large")
+	}
+
+	keys := make([]string, 0, len(context))
+	for k := range context {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	b := binary.BigEndian.AppendUint16(nil, uint16(len(keys)))
+	for _, k := range keys {
+		v := context[k]
+		if len(k) > math.MaxUint16 || len(v) > math.MaxUint16 {
+			return nil, errors.New("esdk: encryption context too large")
+		}
+		b = appendField(b, []byte(k))
+		b = appendField(b, []byte(v))
+	}
+	if len(b) > math.MaxUint16 {
+		return nil, errors.New("esdk: encryption context too large")
+	}
+	return b, nil
+}
+
+// parseContext parses a serialized encryption context.
+func parseContext(b []byte) (map[string]string, error) {
+	context := map[string]string{}
+	if len(b) == 0 {
+		return context, nil
+	}
+
+	d := decoder{b: b}
+	n := int(d.Uint16())
+	for range n {
+		k := string(d.Bytes(int(d.Uint16())))
+		v := string(d.Bytes(int(d.Uint16())))
+		if d.err != nil {
+			break
+		}
+		if _, ok := context[k]; ok {
+			return nil, errMalformed
+		}
+		context[k] = v
+	}
+	if d.err != nil || len(d.b) != 0 || n == 0 {
+		return nil, errMalformed
+	}
+	return context, nil
+}
+
+// verifyContext returns an error if the encryption context
+// contains keys reserved by the message format.
+func verifyContext(context map[string]string) error {
+	for k := range context {
+		if strings.HasPrefix(k, reservedKeyPrefix) {
+			return errors.New("esdk: encryption context key '" + k + "' is reserved")
+		}
+	}
+	return nil
+}
+
+// bodyAAD returns the additional authenticated data of
+// a message body part.
+func bodyAAD(messageID []byte, content string, seq uint32, length uint64) []byte {
+	aad := make([]byte, 0, len(messageID)+len(content)+4+8)
+	aad = append(aad, messageID...)
+	aad = append(aad, content...)
+	aad = binary.BigEndian.AppendUint32(aad, seq)
+	return binary.BigEndian.AppendUint64(aad, length)
+}
+
+// frameIV returns the IV of the frame with the
+// given sequence number.
+func frameIV(seq uint32) []byte {
+	var iv [ivSize]byte
+	binary.BigEndian.PutUint32(iv[ivSize-4:], seq)
+	return iv[:]
+}
+
+// appendField appends the length-prefixed field to b.
+func appendField(b, field []byte) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(field)))
+	return append(b, field...)
+}
+
+// decoder reads big-endian values from a byte slice. Once
+// there are not enough bytes left, it returns zero values
+// and records errMalformed.
+type decoder struct {
+	b   []byte
+	err error
+}
+
+func (d *decoder) Bytes(n int) []byte {
+	if d.err != nil || len(d.b) < n {
+		d.err = errMalformed
+		return nil
+	}
+	v := d.b[:n:n]
+	d.b = d.b[n:]
+	return v
+}
+
+func (d *decoder) Byte() byte {
+	if b := d.Bytes(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) Uint16() uint16 {
+	if b := d.Bytes(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (d *decoder) Uint32() uint32 {
+	if b := d.Bytes(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (d *decoder) Uint64() uint64 {
+	if b := d.Bytes(8); b != nil {
+		return binary.BigEndian.Uint64(b)
+	}
+	return 0
+}
@@ -0,0 +1,303 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package esdk
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openstor/kms-go/kms"
+)
+
+// ProviderID is the key provider ID of encrypted data keys
+// produced by a KMSKeyring.
+const ProviderID = "openstor-kms"
+
+// EncryptedDataKey is a data key encrypted by a key provider.
+// A message contains one or more encrypted data keys. Any of
+// them can be used to decrypt the message.
+type EncryptedDataKey struct {
+	// ProviderID identifies the key provider, like the
+	// KMS or another key management system.
+	ProviderID string
+
+	// ProviderInfo identifies the key within the key provider
+	// that has been used to encrypt the data key.
+	ProviderInfo []byte
+
+	// Ciphertext is the encrypted data key.
+	Ciphertext []byte
+}
+
+// EncryptionMaterials are the cryptographic materials used to
+// encrypt a message.
+type EncryptionMaterials struct {
+	// Suite is the algorithm suite of the message.
+	Suite Suite
+
+	// Context is the encryption context of the message.
+	Context map[string]string
+
+	// DataKey is the plaintext data key. If empty, the
+	// first keyring generates a new data key.
+	DataKey []byte
+
+	// EncryptedDataKeys contains the data key encrypted
+	// by all keyrings.
+	EncryptedDataKeys []EncryptedDataKey
+}
+
+// DecryptionMaterials are the cryptographic materials used to
+// decrypt a message.
+type DecryptionMaterials struct {
+	// Suite is the algorithm suite of the message.
+	Suite Suite
+
+	// Context is the encryption context of the message.
+	Context map[string]string
+
+	// DataKey is the plaintext data key. It is set by
+	// the keyring that decrypts an encrypted data key.
+	DataKey []byte
+}
+
+// Keyring generates, encrypts and decrypts data keys.
+type Keyring interface {
+	// OnEncrypt generates a new data key, if the materials
+	// do not contain one yet, and adds the encrypted data key
+	// to the materials.
+	OnEncrypt(context.Context, *EncryptionMaterials) error
+
+	// OnDecrypt decrypts one of the encrypted data keys and
+	// sets the data key of the materials. It returns an error
+	// if it cannot decrypt any of the encrypted data keys.
+	OnDecrypt(context.Context, *DecryptionMaterials, []EncryptedDataKey) error
+}
+
+// KMSKeyring is a Keyring that uses a KMS key to generate
+// and decrypt data keys.
+//
+// The provider info of its encrypted data keys is the key
+// name and key version, separated by a ':'. The data keys
+// are bound to the encryption context of the message.
+type KMSKeyring struct {
+	// Client is the KMS client used to generate, encrypt
+	// and decrypt data keys.
+	Client *kms.Client
+
+	// Enclave is the enclave containing the Key.
+	Enclave string
+
+	// Key is the name of the KMS key.
+	Key string
+}
+
+var _ Keyring = (*KMSKeyring)(nil) // compiler check
+
+// OnEncrypt generates a new data key using the KMS key, if the
+// materials do not contain one. Otherwise, it encrypts the
+// existing data key using the KMS key.
+func (k *KMSKeyring) OnEncrypt(ctx context.Context, m *EncryptionMaterials) error {
+	aad, err := marshalContext(m.Context)
+	if err != nil {
+		return err
+	}
+
+	var (
+		version    int
+		ciphertext []byte
+	)
+	if len(m.DataKey) == 0 {
+		resp, err := k.Client.GenerateKey(ctx, k.Enclave, &kms.GenerateKeyRequest{
+			Name:           k.Key,
+			AssociatedData: aad,
+			Length:         dataKeySize,
+		})
+		if err != nil {
+			return err
+		}
+		m.DataKey = resp[0].Plaintext
+		version, ciphertext = resp[0].Version, resp[0].Ciphertext
+	} else {
+		resp, err := k.Client.Encrypt(ctx, k.Enclave, &kms.EncryptRequest{
+			Name:           k.Key,
+			Plaintext:      m.DataKey,
+			AssociatedData: aad,
+		})
+		if err != nil {
+			return err
+		}
+		version, ciphertext = resp[0].Version, resp[0].Ciphertext
+	}
+
+	m.EncryptedDataKeys = append(m.EncryptedDataKeys, EncryptedDataKey{
+		ProviderID:   ProviderID,
+		ProviderInfo: []byte(k.Key + ":" + strconv.Itoa(version)),
+		Ciphertext:   ciphertext,
+	})
+	return nil
+}
+
+// OnDecrypt decrypts the first encrypted data key of the
+// KMSKeyring's provider and key that the KMS can decrypt.
+// Encrypted data keys of other providers or keys are ignored.
+func (k *KMSKeyring) OnDecrypt(ctx context.Context, m *DecryptionMaterials, keys []EncryptedDataKey) error {
+	if len(m.DataKey) > 0 {
+		return nil
+	}
+	aad, err := marshalContext(m.Context)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, key := range keys {
+		if key.ProviderID != ProviderID {
+			continue
+		}
+		info := string(key.ProviderInfo)
+		i := strings.LastIndexByte(info, ':')
+		if i < 0 || info[:i] != k.Key {
+			continue
+		}
+		v, err := strconv.Atoi(info[i+1:])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("esdk: invalid provider info '%s'", key.ProviderInfo))
+			continue
+		}
+
+		resp, err := k.Client.Decrypt(ctx, k.Enclave, &kms.DecryptRequest{
+			Name:           k.Key,
+			Version:        v,
+			Ciphertext:     key.Ciphertext,
+			AssociatedData: aad,
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		m.DataKey = resp[0].Plaintext
+		return nil
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("esdk: no encrypted data key for KMS key '%s'", k.Key)
+	}
+	return errors.Join(errs...)
+}
+
+// RawAESKeyring is a Keyring that encrypts data keys with a
+// static AES wrapping key using AES-GCM. It is compatible with
+// the raw AES keyring of the AWS Encryption SDK.
+//
+// The provider ID of its encrypted data keys is the Namespace.
+// The provider info is the key Name followed by the GCM tag
+// length in bits, the IV length in bytes, both as big-endian
+// uint32, and the IV. The data keys are bound to the encryption
+// context of the message.
+type RawAESKeyring struct {
+	// Namespace is the key namespace used as provider ID.
+	// It must not be "aws-kms".
+	Namespace string
+
+	// Name is the name of the wrapping key.
+	Name string
+
+	// Key is the AES-128, AES-192 or AES-256 wrapping key.
+	Key []byte
+}
+
+var _ Keyring = (*RawAESKeyring)(nil) // compiler check
+
+// OnEncrypt generates a new data key, if the materials do
+// not contain one, and encrypts it with the wrapping key.
+func (k *RawAESKeyring) OnEncrypt(_ context.Context, m *EncryptionMaterials) error {
+	aead, err := k.aead()
+	if err != nil {
+		return err
+	}
+	aad, err := marshalContext(m.Context)
+	if err != nil {
+		return err
+	}
+
+	if len(m.DataKey) == 0 {
+		m.DataKey = make([]byte, dataKeySize)
+		if _, err = rand.Read(m.DataKey); err != nil {
+			return err
+		}
+	}
+	iv := make([]byte, ivSize)
+	if _, err = rand.Read(iv); err != nil {
+		return err
+	}
+
+	info := make([]byte, 0, len(k.Name)+8+ivSize)
+	info = append(info, k.Name...)
+	info = binary.BigEndian.AppendUint32(info, tagSize*8)
+	info = binary.BigEndian.AppendUint32(info, ivSize)
+	info = append(info, iv...)
+
+	m.EncryptedDataKeys = append(m.EncryptedDataKeys, EncryptedDataKey{
+		ProviderID:   k.Namespace,
+		ProviderInfo: info,
+		Ciphertext:   aead.Seal(nil, iv, m.DataKey, aad),
+	})
+	return nil
+}
+
+// OnDecrypt decrypts the first encrypted data key of the
+// RawAESKeyring's namespace and key name that the wrapping
+// key can decrypt. Encrypted data keys of other namespaces
+// or keys are ignored.
+func (k *RawAESKeyring) OnDecrypt(_ context.Context, m *DecryptionMaterials, keys []EncryptedDataKey) error {
+	if len(m.DataKey) > 0 {
+		return nil
+	}
+	aead, err := k.aead()
+	if err != nil {
+		return err
+	}
+	aad, err := marshalContext(m.Context)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if key.ProviderID != k.Namespace || !bytes.HasPrefix(key.ProviderInfo, []byte(k.Name)) {
+			continue
+		}
+		info := key.ProviderInfo[len(k.Name):]
+		if len(info) != 8+ivSize || binary.BigEndian.Uint32(info) != tagSize*8 || binary.BigEndian.Uint32(info[4:]) != ivSize {
+			continue
+		}
+
+		dataKey, err := aead.Open(nil, info[8:], key.Ciphertext, aad)
+		if err != nil || len(dataKey) != dataKeySize {
+			continue
+		}
+		m.DataKey = dataKey
+		return nil
+	}
+	return fmt.Errorf("esdk: no encrypted data key for raw AES key '%s'", k.Name)
+}
+
+func (k *RawAESKeyring) aead() (cipher.AEAD, error) {
+	if k.Namespace == "aws-kms" {
+		return nil, errors.New("esdk: key namespace 'aws-kms' is reserved")
+	}
+	block, err := aes.NewCipher(k.Key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
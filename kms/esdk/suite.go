@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package esdk
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+)
+
+// Suite is an algorithm suite of the message format. It
+// defines the message format version, the key derivation and
+// whether the data key is committed to.
+//
+// Only signature-less AES-256-GCM suites are supported.
+type Suite uint16
+
+// Supported algorithm suites.
+const (
+	// AES256GCM represents the suite
+	// AES_256_GCM_IV12_TAG16_NO_KDF. The data key is used
+	// directly as content encryption key.
+	AES256GCM Suite = 0x0078
+
+	// AES256GCMHKDFSHA256 represents the suite
+	// AES_256_GCM_IV12_TAG16_HKDF_SHA256. The content
+	// encryption key is derived from the data key and
+	// message ID.
+	AES256GCMHKDFSHA256 Suite = 0x0178
+
+	// AES256GCMHKDFSHA512Commit represents the suite
+	// AES_256_GCM_HKDF_SHA512_COMMIT_KEY. Messages use the
+	// version 2 message format and commit to the data key.
+	// Hence, a message cannot be decrypted to different
+	// plaintexts using different data keys.
+	AES256GCMHKDFSHA512Commit Suite = 0x0478
+)
+
+// ParseSuite parses s as hex suite ID or suite name and
+// returns the corresponding Suite.
+func ParseSuite(s string) (Suite, error) {
+	switch s {
+	case "0x0078", "AES_256_GCM_IV12_TAG16_NO_KDF":
+		return AES256GCM, nil
+	case "0x0178", "AES_256_GCM_IV12_TAG16_HKDF_SHA256":
+		return AES256GCMHKDFSHA256, nil
+	case "0x0478", "AES_256_GCM_HKDF_SHA512_COMMIT_KEY":
+		return AES256GCMHKDFSHA512Commit, nil
+	default:
+		return 0, fmt.Errorf("esdk: algorithm suite '%s' is not supported", s)
+	}
+}
+
+// String returns the name of the Suite.
+func (s Suite) String() string {
+	switch s {
+	case AES256GCM:
+		return "AES_256_GCM_IV12_TAG16_NO_KDF"
+	case AES256GCMHKDFSHA256:
+		return "AES_256_GCM_IV12_TAG16_HKDF_SHA256"
+	case AES256GCMHKDFSHA512Commit:
+		return "AES_256_GCM_HKDF_SHA512_COMMIT_KEY"
+	default:
+		return "!INVALID:" + strconv.Itoa(int(s))
+	}
+}
+
+// valid reports whether s is a supported suite.
+func (s Suite) valid() bool {
+	return s == AES256GCM || s == AES256GCMHKDFSHA256 || s == AES256GCMHKDFSHA512Commit
+}
+
+// version returns the message format version of s.
+func (s Suite) version() byte {
+	if s == AES256GCMHKDFSHA512Commit {
+		return 2
+	}
+	return 1
+}
+
+// messageIDSize returns the length of message IDs
+// of messages using s.
+func (s Suite) messageIDSize() int {
+	if s.version() == 2 {
+		return 32
+	}
+	return 16
+}
+
+// deriveKey returns the content encryption key and, for
+// committing suites, the commitment key derived from the
+// data key.
+func (s Suite) deriveKey(dataKey, messageID []byte) (key, commitment []byte, err error) {
+	var id [2]byte
+	binary.BigEndian.PutUint16(id[:], uint16(s))
+
+	switch s {
+	case AES256GCM:
+		return dataKey, nil, nil
+	case AES256GCMHKDFSHA256:
+		key, err = hkdf.Key(sha256.New, dataKey, nil, string(id[:])+string(messageID), dataKeySize)
+		return key, nil, err
+	case AES256GCMHKDFSHA512Commit:
+		key, err = hkdf.Key(sha512.New, dataKey, messageID, string(id[:])+"DERIVEKEY", dataKeySize)
+		if err != nil {
+			return nil, nil, err
+		}
+		commitment, err = hkdf.Key(sha512.New, dataKey, messageID, "COMMITKEY", commitmentSize)
+		return key, commitment, err
+	default:
+		return nil, nil, fmt.Errorf("esdk: algorithm suite '%s' is not supported", s)
+	}
+}
@@ -0,0 +1,450 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/internal/headers"
+	"github.com/openstor/kms-go/kms/kmstest"
+	pb "github.com/openstor/kms-go/kms/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// Environment variables controlling fake nodes started by startNode.
+const (
+	envNode    = "KMS_TEST_NODE"         // Run the test binary as fake node
+	envCrash   = "KMS_TEST_NODE_CRASH"   // Step on which the node crashes
+	envCatchUp = "KMS_TEST_NODE_CATCHUP" // Time the node takes to catch up
+)
+
+// Steps on which a fake node may crash, besides any KMS command,
+// like "CLUSTER:PROMOTENODE", received by the node.
+const (
+	crashOnJoin = "join"   // Crash when asked to join a cluster
+	crashJoined = "joined" // Crash right after joining a cluster
+)
+
+// TestMain runs the test binary as fake KMS node, instead of
+// running any tests, if the envNode environment variable is set.
+func TestMain(m *testing.M) {
+	if os.Getenv(envNode) != "" {
+		runNode()
+	}
+	os.Exit(m.Run())
+}
+
+// fakeNode is a fake KMS node running in its own process.
+//
+// Unlike kmstest servers, fake nodes don't share any state.
+// They only implement the commands used by Client.Bootstrap
+// and a fake replication protocol. Hence, a node can crash,
+// i.e. exit, at any step without affecting other nodes.
+type fakeNode struct {
+	Addr string
+
+	cmd    *exec.Cmd
+	exited chan struct{}
+}
+
+// startNode starts a fake KMS node by running the test binary
+// with the given environment variables. The node is killed once
+// the test ends.
+func startNode(t *testing.T, env ...string) *fakeNode {
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	cmd.Env = append(os.Environ(), envNode+"=1")
+	cmd.Env = append(cmd.Env, env...)
+	cmd.Stderr = os.Stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatalf("Failed to start fake node: %v", err)
+	}
+	if err = cmd.Start(); err != nil {
+		t.Fatalf("Failed to start fake node: %v", err)
+	}
+	addr, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		t.Fatalf("Failed to read address of fake node: %v", err)
+	}
+
+	node := &fakeNode{
+		Addr:   strings.TrimSpace(addr),
+		cmd:    cmd,
+		exited: make(chan struct{}),
+	}
+	go func() {
+		io.Copy(io.Discard, stdout)
+		cmd.Wait()
+		close(node.exited)
+	}()
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		<-node.exited
+	})
+	return node
+}
+
+// Exited reports whether the node's process has exited
+// within the given timeout.
+func (n *fakeNode) Exited(timeout time.Duration) bool {
+	select {
+	case <-n.exited:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// newNodeClient returns a SysAdmin client for the fake nodes.
+func newNodeClient(t *testing.T, nodes ...*fakeNode) *kms.Client {
+	key, err := mtls.ParsePrivateKey(kmstest.APIKey)
+	if err != nil {
+		t.Fatalf("Failed to parse API key: %v", err)
+	}
+
+	// All httptest servers, including the ones of fake
+	// nodes, use the same certificate.
+	server := httptest.NewTLSServer(nil)
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+	server.Close()
+
+	endpoints := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		endpoints = append(endpoints, node.Addr)
+	}
+	client, err := kms.NewClient(&kms.Config{
+		Endpoints: endpoints,
+		APIKey:    key,
+		TLS:       &tls.Config{RootCAs: rootCAs},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client
+}
+
+// runNode runs the test binary as fake KMS node. It prints the
+// node's address to stdout and serves requests until the process
+// is killed or crashes.
+func runNode() {
+	n := &node{
+		crash:   os.Getenv(envCrash),
+		members: map[string]*member{},
+	}
+	if s := os.Getenv(envCatchUp); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		n.catchUp = d
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /version", n.handleVersion)
+	mux.HandleFunc("POST /v1/kms/{enclave...}", n.handleKMS)
+	mux.HandleFunc("POST /fake/join", n.handleJoin)
+	mux.HandleFunc("POST /fake/leave", n.handleLeave)
+	mux.HandleFunc("GET /fake/commit", n.handleCommit)
+
+	server := httptest.NewUnstartedServer(mux)
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+
+	n.addr, n.client = server.Listener.Addr().String(), server.Client()
+	n.client.Timeout = 500 * time.Millisecond
+	n.leave()
+
+	fmt.Println(n.addr)
+	select {}
+}
+
+// node is the state of a fake KMS node.
+type node struct {
+	addr    string
+	client  *http.Client // Client for requests to other nodes
+	crash   string
+	catchUp time.Duration
+
+	mu       sync.Mutex
+	leader   string             // Address of the cluster leader
+	members  map[string]*member // Cluster members by address. Only maintained by the leader
+	nextID   int
+	commit   uint64
+	joinedAt time.Time
+}
+
+// member is a member of the leader's cluster.
+type member struct {
+	id   int
+	role kms.NodeRole
+}
+
+// joinRequest is sent by the leader to a node joining its cluster.
+type joinRequest struct {
+	Leader string
+	Commit uint64
+}
+
+// leave makes the node the leader of a new single-node cluster.
+func (n *node) leave() {
+	n.leader, n.nextID, n.commit = n.addr, 1, 0
+	n.members = map[string]*member{n.addr: {id: 0, role: kms.NodeVoter}}
+}
+
+func (n *node) handleJoin(w http.ResponseWriter, r *http.Request) {
+	if n.crash == crashOnJoin {
+		os.Exit(1)
+	}
+
+	var req joinRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	n.leader, n.commit, n.joinedAt = req.Leader, req.Commit, time.Now()
+	n.members = map[string]*member{}
+	n.mu.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+	if n.crash == crashJoined {
+		http.NewResponseController(w).Flush()
+		os.Exit(1)
+	}
+}
+
+func (n *node) handleLeave(w http.ResponseWriter, _ *http.Request) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.leave()
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleCommit returns the node's commit index. It is 0
+// until the node has caught up with the leader.
+func (n *node) handleCommit(w http.ResponseWriter, _ *http.Request) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var commit uint64
+	if time.Since(n.joinedAt) >= n.catchUp {
+		commit = n.commit
+	}
+	w.Write(strconv.AppendUint(nil, commit, 10))
+}
+
+func (n *node) handleVersion(w http.ResponseWriter, _ *http.Request) {
+	writeNodeResponse(w, must(proto.Marshal(&pb.VersionResponse{
+		Version:    kmstest.Version,
+		APIVersion: "v1",
+		Host:       n.addr,
+	})))
+}
+
+func (n *node) handleKMS(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeNodeError(w, kms.Error{Code: http.StatusBadRequest, Err: err.Error()})
+		return
+	}
+
+	cmd, _ := cmds.Inspect(body)
+	if cmd.String() == n.crash {
+		os.Exit(1)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var resp []byte
+	switch cmd {
+	case cmds.ClusterStatus:
+		resp, err = cmds.Encode(nil, cmd, n.status())
+	case cmds.EnclaveList:
+		resp, err = cmds.EncodePB(nil, cmd, &pb.ListEnclavesResponse{})
+	case cmds.ClusterAddNode:
+		var req kms.AddClusterNodeRequest
+		if _, err = cmds.Decode(body, cmd, &req); err == nil {
+			err = n.addNode(req.Host, req.Role)
+		}
+	case cmds.ClusterRemoveNode:
+		var req kms.RemoveClusterNodeRequest
+		if _, err = cmds.Decode(body, cmd, &req); err == nil {
+			err = n.removeNode(req.Host, req.DeleteClusterOnHost)
+		}
+	case cmds.ClusterPromoteNode:
+		var req kms.PromoteClusterNodeRequest
+		if _, err = cmds.Decode(body, cmd, &req); err == nil {
+			err = n.promoteNode(req.Host)
+		}
+	default:
+		err = kms.Error{Code: http.StatusNotImplemented, Err: "fake node: command '" + cmd.String() + "' is not supported"}
+	}
+	if err != nil {
+		var kmsErr kms.Error
+		if !errors.As(err, &kmsErr) {
+			kmsErr = kms.Error{Code: http.StatusBadRequest, Err: err.Error()}
+		}
+		writeNodeError(w, kmsErr)
+		return
+	}
+	writeNodeResponse(w, resp)
+}
+
+// status returns the cluster status. The leader fetches the commit
+// index of all other members and reports unreachable members as
+// down. Any other node only reports itself and the leader.
+func (n *node) status() *kms.ClusterStatusResponse {
+	status := &kms.ClusterStatusResponse{
+		NodesUp:   map[int]*kms.ServerStatusResponse{},
+		NodesDown: map[int]string{},
+		NodeRoles: map[int]kms.NodeRole{},
+	}
+	if n.leader != n.addr {
+		status.NodesUp[1] = &kms.ServerStatusResponse{Host: n.addr, ID: 1, LeaderID: 0, NodeRole: kms.NodeLearner}
+		status.NodesDown[0] = n.leader
+		status.NodeRoles[0], status.NodeRoles[1] = kms.NodeVoter, kms.NodeLearner
+		return status
+	}
+
+	for addr, m := range n.members {
+		status.NodeRoles[m.id] = m.role
+
+		commit := n.commit
+		if addr != n.addr {
+			var err error
+			if commit, err = n.fetchCommit(addr); err != nil {
+				status.NodesDown[m.id] = addr
+				continue
+			}
+		}
+		status.NodesUp[m.id] = &kms.ServerStatusResponse{
+			Version:    kmstest.Version,
+			APIVersion: "v1",
+			Host:       addr,
+			Commit:     commit,
+			ID:         m.id,
+			NodeRole:   m.role,
+			LeaderID:   n.members[n.addr].id,
+		}
+	}
+	return status
+}
+
+func (n *node) fetchCommit(addr string) (uint64, error) {
+	resp, err := n.client.Get("https://" + addr + "/fake/commit")
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(string(b), 10, 64)
+}
+
+func (n *node) addNode(host string, role kms.NodeRole) error {
+	host = strings.TrimPrefix(host, "https://")
+	if _, ok := n.members[host]; ok {
+		return kms.Error{Code: http.StatusConflict, Err: "node already exists"}
+	}
+
+	req, _ := json.Marshal(joinRequest{Leader: n.addr, Commit: n.commit + 1})
+	resp, err := n.client.Post("https://"+host+"/fake/join", "application/json", bytes.NewReader(req))
+	if err != nil {
+		return kms.Error{Code: http.StatusBadGateway, Err: "fake node: node '" + host + "' is not reachable"}
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return kms.Error{Code: http.StatusBadGateway, Err: "fake node: node '" + host + "' failed to join"}
+	}
+
+	if role == 0 {
+		role = kms.NodeVoter
+	}
+	n.members[host] = &member{id: n.nextID, role: role}
+	n.nextID++
+	n.commit++
+	return nil
+}
+
+func (n *node) removeNode(host string, deleteCluster bool) error {
+	host = strings.TrimPrefix(host, "https://")
+	if _, ok := n.members[host]; !ok || host == n.addr {
+		return kms.ErrNodeNotFound
+	}
+
+	// A node that is down cannot delete its cluster state.
+	// It is removed from the cluster anyway.
+	if deleteCluster {
+		if resp, err := n.client.Post("https://"+host+"/fake/leave", "", nil); err == nil {
+			resp.Body.Close()
+		}
+	}
+	delete(n.members, host)
+	n.commit++
+	return nil
+}
+
+func (n *node) promoteNode(host string) error {
+	m, ok := n.members[strings.TrimPrefix(host, "https://")]
+	if !ok {
+		return kms.ErrNodeNotFound
+	}
+	m.role = kms.NodeVoter
+	n.commit++
+	return nil
+}
+
+func writeNodeResponse(w http.ResponseWriter, body []byte) {
+	w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+	w.Header().Set(headers.ContentLength, strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+func writeNodeError(w http.ResponseWriter, err kms.Error) {
+	body := must(proto.Marshal(&pb.ErrResponse{Message: err.Err}))
+
+	w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+	w.Header().Set(headers.ContentLength, strconv.Itoa(len(body)))
+	w.WriteHeader(err.Code)
+	w.Write(body)
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"cmp"
+	"iter"
+	"net/http"
+	"slices"
+	"time"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms/cmds"
+)
+
+// AuditRecord is a record of a KMS command executed, or
+// rejected, by a KMS server on behalf of a client.
+type AuditRecord struct {
+	Time     time.Time     // The point in time when the KMS server received the command
+	Identity mtls.Identity // The identity of the client that sent the command
+	Enclave  string        // The enclave the command operated within, if any
+	Cmd      cmds.Command  // The KMS command
+	Arg      string        // The command argument. For example, the key name
+
+	StatusCode int // The response status code sent to the client
+}
+
+// PolicyImpact describes the commands of one identity that
+// have been allowed in the past but would be rejected by a
+// proposed policy.
+type PolicyImpact struct {
+	Identity mtls.Identity // The identity that sent the commands
+	Cmd      cmds.Command  // The KMS command
+	Records  []AuditRecord // All rejected audit records in the order they occurred
+}
+
+// AnalyzePolicy replays the audit records and reports which of
+// the commands, that were allowed in the past, would be rejected
+// by the proposed policy.
+//
+// Only records of the given identities are evaluated. Usually,
+// these are the identities the policy is or will be assigned to.
+// Records of commands that have been rejected in the past, with
+// a 403 Forbidden status code, are ignored.
+//
+// The returned PolicyImpacts are grouped by identity and command
+// and sorted in ascending order. If the proposed policy would not
+// reject any previously allowed command, AnalyzePolicy returns an
+// empty slice.
+func AnalyzePolicy(policy *Policy, records iter.Seq[AuditRecord], identities ...mtls.Identity) []PolicyImpact {
+	type Group struct {
+		Identity mtls.Identity
+		Cmd      cmds.Command
+	}
+
+	groups := map[Group][]AuditRecord{}
+	for record := range records {
+		if !slices.Contains(identities, record.Identity) {
+			continue
+		}
+		if record.StatusCode == http.StatusForbidden {
+			continue
+		}
+		if policy.Verify(record.Cmd, record.Arg) == nil {
+			continue
+		}
+
+		g := Group{Identity: record.Identity, Cmd: record.Cmd}
+		groups[g] = append(groups[g], record)
+	}
+
+	impacts := make([]PolicyImpact, 0, len(groups))
+	for g, records := range groups {
+		impacts = append(impacts, PolicyImpact{
+			Identity: g.Identity,
+			Cmd:      g.Cmd,
+			Records:  records,
+		})
+	}
+	slices.SortFunc(impacts, func(a, b PolicyImpact) int {
+		if n := cmp.Compare(a.Identity.String(), b.Identity.String()); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.Cmd, b.Cmd)
+	})
+	return impacts
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms/cmds"
+)
+
+func TestAnalyzePolicy(t *testing.T) {
+	t.Parallel()
+
+	alice, err := mtls.ParseIdentity("h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w")
+	if err != nil {
+		t.Fatalf("Failed to parse identity: %v", err)
+	}
+	bob, err := mtls.ParseIdentity("h1:LLnMSqIkCHnhAO7wNz5E4oe_TPyA8E4oe7c4-KntrdI")
+	if err != nil {
+		t.Fatalf("Failed to parse identity: %v", err)
+	}
+
+	records := []AuditRecord{
+		{Identity: alice, Cmd: cmds.KeyDecrypt, Arg: "my-key", StatusCode: http.StatusOK},
+		{Identity: alice, Cmd: cmds.KeyDecrypt, Arg: "other-key", StatusCode: http.StatusOK},
+		{Identity: alice, Cmd: cmds.KeyGenerate, Arg: "other-key", StatusCode: http.StatusOK},
+		{Identity: alice, Cmd: cmds.KeyCreate, Arg: "my-key", StatusCode: http.StatusForbidden},
+		{Identity: bob, Cmd: cmds.KeyDecrypt, Arg: "other-key", StatusCode: http.StatusOK},
+	}
+	policy := &Policy{
+		Allow: map[cmds.Command]RuleSet{
+			cmds.KeyDecrypt:  {"*": {}},
+			cmds.KeyGenerate: {"my-key*": {}},
+		},
+		Deny: map[cmds.Command]RuleSet{
+			cmds.KeyDecrypt: {"other-*": {}},
+		},
+	}
+
+	impacts := AnalyzePolicy(policy, slices.Values(records), alice)
+	if len(impacts) != 2 {
+		t.Fatalf("Got %d impacts - want 2", len(impacts))
+	}
+	for i, want := range []cmds.Command{cmds.KeyDecrypt, cmds.KeyGenerate} {
+		impact := impacts[i]
+		if impact.Identity != alice || impact.Cmd != want {
+			t.Fatalf("Impact %d mismatch: got '%v %v' - want '%v %v'", i, impact.Identity, impact.Cmd, alice, want)
+		}
+		if len(impact.Records) != 1 || impact.Records[0].Arg != "other-key" {
+			t.Fatalf("Impact %d: invalid records: %v", i, impact.Records)
+		}
+	}
+
+	if impacts = AnalyzePolicy(policy, slices.Values(records)); len(impacts) != 0 {
+		t.Fatalf("Got %d impacts without identities - want 0", len(impacts))
+	}
+}
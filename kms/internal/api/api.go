@@ -31,4 +31,5 @@ const (
 const (
 	QueryReadyWrite  = "write"
 	QueryRestartSelf = "self"
+	QuerySince       = "since"
 )
@@ -25,6 +25,12 @@ const (
 	XFrameOptions = "X-Frame-Options" // Non-standard
 )
 
+// KMS-specific HTTP headers.
+const (
+	KMSCommit = "Kms-Commit"  // Commit index of a database snapshot
+	KMSDryRun = "Kms-Dry-Run" // Set by servers on responses to dry-run requests
+)
+
 // Commonly used HTTP content type values.
 const (
 	ContentTypeAny       = "*/*"
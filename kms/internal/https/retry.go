@@ -112,10 +112,14 @@ func (lb *LoadBalancer) Host() (string, error) {
 // Hosts, for which requests fail, are temporarily excluded and no longer
 // selected for subsequent requests or retries.
 func (lb *LoadBalancer) RoundTrip(req *http.Request) (*http.Response, error) {
+	attempts, _ := req.Context().Value(attemptsKey{}).(*[]Attempt)
+
+	start := time.Now()
 	resp, err := lb.RoundTripper.RoundTrip(req)
 	if err != nil && isRetryable(err) {
 		r := slices.Index(lb.Hosts, req.URL.Host)
 		if r < 0 {
+			recordAttempt(attempts, req.URL.Host, err, start, false)
 			return resp, err
 		}
 
@@ -126,6 +130,7 @@ func (lb *LoadBalancer) RoundTrip(req *http.Request) (*http.Response, error) {
 		}
 		lb.timeout[req.URL.Host] = now
 		lb.mu.Unlock()
+		recordAttempt(attempts, req.URL.Host, err, start, true)
 
 		t := timeout(lb.Timeout)
 		for i := 1; i < len(lb.Hosts); i++ {
@@ -141,19 +146,57 @@ func (lb *LoadBalancer) RoundTrip(req *http.Request) (*http.Response, error) {
 			closeResponseBody(resp)
 
 			req.URL.Host = lb.Hosts[r]
+			start = time.Now()
 			resp, err = lb.RoundTripper.RoundTrip(req)
 			if err == nil || !isRetryable(err) {
+				recordAttempt(attempts, req.URL.Host, err, start, false)
 				return resp, err
 			}
 
 			lb.mu.Lock()
 			lb.timeout[req.URL.Host] = time.Now()
 			lb.mu.Unlock()
+			recordAttempt(attempts, req.URL.Host, err, start, true)
 		}
+		return resp, err
 	}
+	recordAttempt(attempts, req.URL.Host, err, start, false)
 	return resp, err
 }
 
+// Attempt describes a single attempt of the LoadBalancer
+// to send a request to one of its hosts.
+type Attempt struct {
+	Host      string        // The host the request has been sent to
+	Err       error         // The error, if any, returned by the host
+	Duration  time.Duration // How long the attempt took
+	Suspended bool          // Whether the host has been suspended due to Err
+}
+
+type attemptsKey struct{}
+
+// RecordAttempts returns a copy of ctx that makes the LoadBalancer
+// append every attempt to send a request with the returned context
+// to attempts.
+//
+// The attempts must not be accessed concurrently while a request
+// is in progress.
+func RecordAttempts(ctx context.Context, attempts *[]Attempt) context.Context {
+	return context.WithValue(ctx, attemptsKey{}, attempts)
+}
+
+func recordAttempt(attempts *[]Attempt, host string, err error, start time.Time, suspended bool) {
+	if attempts == nil {
+		return
+	}
+	*attempts = append(*attempts, Attempt{
+		Host:      host,
+		Err:       err,
+		Duration:  time.Since(start),
+		Suspended: suspended,
+	})
+}
+
 func timeout(d time.Duration) time.Duration {
 	if d <= 0 {
 		return 30 * time.Second
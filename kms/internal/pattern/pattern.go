@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package pattern implements the matching rules of KMS
+// policy patterns, like "app-*" or "team/+/prod/*".
+//
+// Match decides whether a pattern matches a single name. It
+// implements kms.RuleSet.Match. Subset and Intersect compare
+// the sets of names matched by patterns, for example to check
+// assertions about policies. They compile each pattern into a
+// nondeterministic automaton and explore the automata
+// simultaneously. The automata accept exactly the names Match
+// accepts.
+package pattern
+
+import "strings"
+
+// Match reports whether pattern matches s.
+//
+// A pattern that ends with an asterisk ('*') character matches
+// any s that starts with the pattern, without the asterisk.
+// Any other asterisk only matches itself. A plus ('+') that
+// forms an entire segment, i.e. is surrounded by '/' or the
+// pattern boundaries, matches any non-empty sequence of
+// characters within one segment of s, i.e. without a '/'.
+// A pattern without wildcards only matches s if both are
+// equal. The empty pattern matches nothing.
+func Match(pattern, s string) bool {
+	if pattern == "" {
+		return false
+	}
+	for {
+		p, next, more := strings.Cut(pattern, "/")
+		if !more && strings.HasSuffix(p, "*") { // Trailing asterisk
+			return strings.HasPrefix(s, p[:len(p)-1])
+		}
+
+		segment, rest, ok := strings.Cut(s, "/")
+		if p == "+" && segment == "" || p != "+" && p != segment {
+			return false
+		}
+		if !more || !ok {
+			return !more && !ok
+		}
+		pattern, s = next, rest
+	}
+}
+
+// Subset reports whether every name matched by all patterns
+// in sub, i.e. by their intersection, is matched by sup.
+func Subset(sup string, sub ...string) bool {
+	patterns := make([]*pattern, 0, len(sub)+1)
+	for _, p := range sub {
+		patterns = append(patterns, compile(p))
+	}
+	patterns = append(patterns, compile(sup))
+
+	n := len(sub)
+	found := explore(patterns, func(states []stateSet) bool {
+		for i := range n {
+			if !patterns[i].Accepts(states[i]) {
+				return false
+			}
+		}
+		return !patterns[n].Accepts(states[n])
+	}, n)
+	return !found
+}
+
+// Intersect reports whether at least one name is
+// matched by all patterns.
+func Intersect(patterns ...string) bool {
+	compiled := make([]*pattern, 0, len(patterns))
+	for _, p := range patterns {
+		compiled = append(compiled, compile(p))
+	}
+	return explore(compiled, func(states []stateSet) bool {
+		for i, p := range compiled {
+			if !p.Accepts(states[i]) {
+				return false
+			}
+		}
+		return true
+	}, len(compiled))
+}
+
+// explore reports whether any state of the simultaneously running
+// automata, reachable by some name, satisfies the predicate. Once
+// one of the first required automata has no states left, no
+// further name can satisfy the predicate.
+func explore(patterns []*pattern, predicate func([]stateSet) bool, required int) bool {
+	alphabet := alphabet(patterns)
+
+	start := make([]stateSet, len(patterns))
+	for i, p := range patterns {
+		start[i] = p.Start()
+	}
+	queue := [][]stateSet{start}
+	seen := map[string]bool{key(start): true}
+	for len(queue) > 0 {
+		states := queue[0]
+		queue = queue[1:]
+		if predicate(states) {
+			return true
+		}
+
+	Next:
+		for _, c := range alphabet {
+			next := make([]stateSet, len(patterns))
+			for i, p := range patterns {
+				next[i] = p.Step(states[i], c)
+				if i < required && next[i].Empty() {
+					continue Next
+				}
+			}
+			if k := key(next); !seen[k] {
+				seen[k] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// alphabet returns one representative of each class of
+// characters the patterns treat differently: all literal
+// characters, the '/' and one other character.
+func alphabet(patterns []*pattern) []byte {
+	var used [256]bool
+	used['/'] = true
+	for _, p := range patterns {
+		for _, t := range p.tokens {
+			if t.kind == tokenLiteral {
+				used[t.char] = true
+			}
+		}
+	}
+
+	var alphabet []byte
+	other := -1
+	for c := range used {
+		if used[c] {
+			alphabet = append(alphabet, byte(c))
+		} else if other < 0 {
+			other = c
+		}
+	}
+	if other >= 0 {
+		alphabet = append(alphabet, byte(other))
+	}
+	return alphabet
+}
+
+func key(states []stateSet) string {
+	var sb strings.Builder
+	for _, s := range states {
+		sb.WriteString(string(s))
+		sb.WriteByte('|')
+	}
+	return sb.String()
+}
+
+type tokenKind uint8
+
+const (
+	tokenLiteral tokenKind = iota // A single character
+	tokenSegment                  // One character of a '+' forming an entire segment
+	tokenRepeat                   // Any further characters of a '+' segment
+	tokenTail                     // A trailing asterisk
+)
+
+type token struct {
+	kind tokenKind
+	char byte
+}
+
+// pattern is a compiled policy pattern. The automaton's
+// state i means that the first i tokens have been matched.
+type pattern struct {
+	tokens []token
+	empty  bool // The empty pattern matches nothing
+}
+
+// stateSet is a set of automaton states. The i-th byte
+// is 1 if the state i is in the set.
+type stateSet []byte
+
+func (s stateSet) Empty() bool { return !strings.Contains(string(s), "\x01") }
+
+func compile(p string) *pattern {
+	if p == "" {
+		return &pattern{empty: true}
+	}
+
+	tokens := make([]token, 0, len(p))
+	for i := 0; i < len(p); i++ {
+		switch {
+		case p[i] == '*' && i == len(p)-1:
+			tokens = append(tokens, token{kind: tokenTail})
+		case p[i] == '+' && (i == 0 || p[i-1] == '/') && (i == len(p)-1 || p[i+1] == '/'):
+			tokens = append(tokens, token{kind: tokenSegment}, token{kind: tokenRepeat})
+		default:
+			tokens = append(tokens, token{kind: tokenLiteral, char: p[i]})
+		}
+	}
+	return &pattern{tokens: tokens}
+}
+
+// Start returns the states before matching any character.
+func (p *pattern) Start() stateSet {
+	s := make(stateSet, len(p.tokens)+1)
+	if !p.empty {
+		s[0] = 1
+		p.closure(s)
+	}
+	return s
+}
+
+// Step returns the states after matching the character c.
+func (p *pattern) Step(s stateSet, c byte) stateSet {
+	next := make(stateSet, len(s))
+	for i, t := range p.tokens {
+		if s[i] == 0 {
+			continue
+		}
+		switch t.kind {
+		case tokenLiteral:
+			if t.char == c {
+				next[i+1] = 1
+			}
+		case tokenSegment:
+			if c != '/' {
+				next[i+1] = 1
+			}
+		case tokenRepeat:
+			if c != '/' {
+				next[i] = 1
+			}
+		case tokenTail:
+			next[i] = 1
+		}
+	}
+	p.closure(next)
+	return next
+}
+
+// Accepts reports whether s contains the final state.
+func (p *pattern) Accepts(s stateSet) bool { return s[len(p.tokens)] == 1 }
+
+// closure adds all states reachable without matching a
+// character, i.e. by wildcards matching nothing.
+func (p *pattern) closure(s stateSet) {
+	for i, t := range p.tokens {
+		if s[i] == 1 && (t.kind == tokenRepeat || t.kind == tokenTail) {
+			s[i+1] = 1
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package pattern
+
+import "testing"
+
+// TestAutomaton checks that the compiled automata accept
+// exactly the names accepted by Match.
+func TestAutomaton(t *testing.T) {
+	t.Parallel()
+
+	for _, pattern := range automatonPatterns {
+		p := compile(pattern)
+		for _, name := range automatonNames {
+			s := p.Start()
+			for i := 0; i < len(name) && !s.Empty(); i++ {
+				s = p.Step(s, name[i])
+			}
+			if accept, match := p.Accepts(s), Match(pattern, name); accept != match {
+				t.Fatalf("'%s' matching '%s': automaton accepts: %v - Match: %v", pattern, name, accept, match)
+			}
+		}
+	}
+}
+
+var automatonPatterns = []string{
+	"", "*", "+", "+/*", "+/+", "+/key", "my-key", "my-key*",
+	"team/*", "team/app*", "team/+", "team/+*", "team/+/key",
+	"team/+/prod/*", "team/*/key", "team/app-+/key", "team//key",
+}
+
+var automatonNames = []string{
+	"", "/", "//", "+", "*", "my-key", "my-key-1", "/key", "team",
+	"team/", "team/app", "team/application", "team//key", "team/+1",
+	"team/app/key", "team/app/key-1", "team/*/key", "team/app-+/key",
+	"team/app/prod/key", "team//prod/key", "team/app/dev/prod/key",
+}
+
+func TestSubset(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range patternTests {
+		if s := Subset(test.Sup, test.Sub); s != test.Subset {
+			t.Fatalf("Test %d: Subset('%s', '%s'): got %v - want %v", i, test.Sup, test.Sub, s, test.Subset)
+		}
+		if s := Intersect(test.Sup, test.Sub); s != test.Intersect {
+			t.Fatalf("Test %d: Intersect('%s', '%s'): got %v - want %v", i, test.Sup, test.Sub, s, test.Intersect)
+		}
+	}
+}
+
+var patternTests = []struct {
+	Sup, Sub          string
+	Subset, Intersect bool
+}{
+	{Sup: "*", Sub: "app-*", Subset: true, Intersect: true},
+	{Sup: "app-*", Sub: "*", Subset: false, Intersect: true},
+	{Sup: "app-*", Sub: "db-*", Subset: false, Intersect: false},
+	{Sup: "app-1", Sub: "app-1", Subset: true, Intersect: true},
+	{Sup: "", Sub: "app-1", Subset: false, Intersect: false},
+	{Sup: "team/*", Sub: "team/+/prod/*", Subset: true, Intersect: true},
+	{Sup: "team/+/prod/*", Sub: "team/*", Subset: false, Intersect: true},
+	{Sup: "team/+/prod/*", Sub: "team/app/prod/db-*", Subset: true, Intersect: true},
+	{Sup: "team/+/prod/*", Sub: "team/app/dev/*", Subset: false, Intersect: false},
+	{Sup: "team/+/key", Sub: "team/app/prod/*", Subset: false, Intersect: false},
+	{Sup: "+/*", Sub: "team/+/key", Subset: true, Intersect: true},
+	{Sup: "team/+/key", Sub: "+/app/*", Subset: false, Intersect: true},
+	{Sup: "team/*/key", Sub: "team/app/key", Subset: false, Intersect: false},
+	{Sup: "team/app-+", Sub: "team/app-1", Subset: false, Intersect: false},
+	{Sup: "team/+/*", Sub: "team/+/key", Subset: true, Intersect: true},
+	{Sup: "team/+/key", Sub: "team/*", Subset: false, Intersect: true},
+	{Sup: "team/+*", Sub: "team/+", Subset: false, Intersect: true},
+	{Sup: "team/*", Sub: "team/+", Subset: true, Intersect: true},
+	{Sup: "team/+", Sub: "team/*", Subset: false, Intersect: true},
+	{Sup: "team//key", Sub: "team/+/key", Subset: false, Intersect: false},
+}
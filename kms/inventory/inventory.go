@@ -0,0 +1,370 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package inventory implements a scanner for stored ciphertexts.
+//
+// Before retiring a key version, operators have to know whether
+// stored ciphertexts still depend on it. The scanner parses the
+// headers of KMS and KES ciphertexts, without decrypting them, and
+// reports how many ciphertexts exist per key name and version:
+//
+//	report, err := inventory.Scan(inventory.ReadDir(os.DirFS("/data"), "."))
+//	if err != nil {
+//		// handle error
+//	}
+//	for kv, n := range report.Keys {
+//		fmt.Printf("%s version %d: %d ciphertexts\n", kv.Name, kv.Version, n)
+//	}
+//
+// A Rewrapper re-encrypts KMS ciphertexts with the latest key
+// version such that older key versions can be retired safely.
+package inventory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+)
+
+// Format is a ciphertext format.
+type Format uint
+
+// Supported ciphertext formats.
+const (
+	// FormatKMS is the JSON encoding of a data encryption
+	// key sealed by a KMS server, as stored by MinIO:
+	//
+	//	{
+	//	  "keyid":      "<key name>",
+	//	  "version":    <key version>,
+	//	  "ciphertext": "<base64>"
+	//	}
+	//
+	// The ciphertext is the one returned by the KMS server
+	// as part of a kms.EncryptResponse or kms.GenerateKeyResponse.
+	// The version is omitted by KMS clients that did not track
+	// key versions. Such ciphertexts have been produced by an
+	// unknown key version and are reported with version 0.
+	FormatKMS Format = iota + 1
+
+	// FormatKES is the format of ciphertexts produced by
+	// a KES server. KES servers encode ciphertexts either
+	// as JSON:
+	//
+	//	{
+	//	  "aead":  "<algorithm>",
+	//	  "id":    "<key ID>",
+	//	  "iv":    "<base64>",
+	//	  "nonce": "<base64>",
+	//	  "bytes": "<base64>"
+	//	}
+	//
+	// or as MessagePack array of the same five fields, in
+	// the same order. KES ciphertexts identify the key by
+	// its ID and don't contain a key version.
+	FormatKES
+)
+
+// String returns the Format's string representation.
+func (f Format) String() string {
+	switch f {
+	case FormatKMS:
+		return "KMS"
+	case FormatKES:
+		return "KES"
+	default:
+		return "!INVALID:" + strconv.Itoa(int(f))
+	}
+}
+
+// Header is the parsed header of a ciphertext. It identifies
+// the key required to decrypt the ciphertext.
+type Header struct {
+	Format  Format // The ciphertext format
+	Name    string // The key name or, for KES ciphertexts, the key ID
+	Version int    // The key version. 0 if unknown and for KES ciphertexts
+}
+
+// KeyVersion identifies a key version within a Report.
+type KeyVersion struct {
+	Format  Format
+	Name    string
+	Version int
+}
+
+// ParseHeader parses the ciphertext header of b. It returns an
+// error if b is neither a KMS nor a KES ciphertext.
+func ParseHeader(b []byte) (Header, error) {
+	var h Header
+	_, err := parseCiphertext(b, &h)
+	return h, err
+}
+
+// parseCiphertext parses the header of b into h. For KMS
+// ciphertexts, it also returns the encrypted ciphertext.
+func parseCiphertext(b []byte, h *Header) ([]byte, error) {
+	type JSON struct {
+		KeyID      *string `json:"keyid"`
+		Version    *int    `json:"version"`
+		Ciphertext []byte  `json:"ciphertext"`
+
+		Algorithm *string `json:"aead"`
+		ID        string  `json:"id"`
+		IV        []byte  `json:"iv"`
+		Nonce     []byte  `json:"nonce"`
+		Bytes     []byte  `json:"bytes"`
+	}
+	if len(b) > 0 && b[0] == msgpArray5 {
+		id, err := parseKESBinary(b)
+		if err != nil {
+			return nil, err
+		}
+		*h = Header{Format: FormatKES, Name: id}
+		return nil, nil
+	}
+
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || b[0] != '{' {
+		return nil, errors.New("inventory: unknown ciphertext format")
+	}
+
+	var v JSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("inventory: invalid ciphertext: %v", err)
+	}
+	switch {
+	case v.KeyID != nil && v.Algorithm == nil:
+		if *v.KeyID == "" {
+			return nil, errors.New("inventory: invalid KMS ciphertext: empty key name")
+		}
+		if len(v.Ciphertext) == 0 {
+			return nil, errors.New("inventory: invalid KMS ciphertext: empty ciphertext")
+		}
+		var version int
+		if v.Version != nil {
+			if version = *v.Version; version < 0 {
+				return nil, fmt.Errorf("inventory: invalid KMS ciphertext: invalid key version '%d'", version)
+			}
+		}
+		*h = Header{Format: FormatKMS, Name: *v.KeyID, Version: version}
+		return v.Ciphertext, nil
+	case v.Algorithm != nil && v.KeyID == nil:
+		if *v.Algorithm == "" || len(v.IV) == 0 || len(v.Nonce) == 0 || len(v.Bytes) == 0 {
+			return nil, errors.New("inventory: invalid KES ciphertext")
+		}
+		*h = Header{Format: FormatKES, Name: v.ID}
+		return nil, nil
+	default:
+		return nil, errors.New("inventory: unknown ciphertext format")
+	}
+}
+
+// MessagePack type prefixes used by KES ciphertexts.
+const (
+	msgpArray5 = 0x95 // fixarray with 5 elements
+	msgpStr8   = 0xd9
+	msgpStr16  = 0xda
+	msgpStr32  = 0xdb
+	msgpBin8   = 0xc4
+	msgpBin16  = 0xc5
+	msgpBin32  = 0xc6
+)
+
+// parseKESBinary parses the MessagePack encoding of a KES
+// ciphertext and returns its key ID.
+func parseKESBinary(b []byte) (string, error) {
+	errInvalid := errors.New("inventory: invalid KES ciphertext")
+
+	b = b[1:] // Skip array header
+	var fields [5][]byte
+	for i := range fields {
+		var (
+			isStr bool
+			err   error
+		)
+		if fields[i], isStr, b, err = msgpReadBytes(b); err != nil {
+			return "", errInvalid
+		}
+		if isStr != (i < 2) { // Algorithm and key ID are strings
+			return "", errInvalid
+		}
+		if i != 1 && len(fields[i]) == 0 {
+			return "", errInvalid
+		}
+	}
+	if len(b) != 0 {
+		return "", errInvalid
+	}
+	return string(fields[1]), nil
+}
+
+// msgpReadBytes reads a MessagePack string or binary value
+// from b. It reports whether the value is a string and returns
+// the remaining bytes.
+func msgpReadBytes(b []byte) (value []byte, isStr bool, rest []byte, err error) {
+	if len(b) == 0 {
+		return nil, false, nil, errors.New("inventory: unexpected end of MessagePack data")
+	}
+
+	var n, size int
+	switch t := b[0]; {
+	case t&0xe0 == 0xa0: // fixstr
+		n, size, isStr = int(t&0x1f), 1, true
+	case t == msgpStr8 || t == msgpBin8:
+		if len(b) < 2 {
+			return nil, false, nil, errors.New("inventory: unexpected end of MessagePack data")
+		}
+		n, size, isStr = int(b[1]), 2, t == msgpStr8
+	case t == msgpStr16 || t == msgpBin16:
+		if len(b) < 3 {
+			return nil, false, nil, errors.New("inventory: unexpected end of MessagePack data")
+		}
+		n, size, isStr = int(binary.BigEndian.Uint16(b[1:])), 3, t == msgpStr16
+	case t == msgpStr32 || t == msgpBin32:
+		if len(b) < 5 {
+			return nil, false, nil, errors.New("inventory: unexpected end of MessagePack data")
+		}
+		n, size, isStr = int(binary.BigEndian.Uint32(b[1:])), 5, t == msgpStr32
+	default:
+		return nil, false, nil, fmt.Errorf("inventory: unexpected MessagePack type '%#x'", t)
+	}
+	if len(b)-size < n {
+		return nil, false, nil, errors.New("inventory: unexpected end of MessagePack data")
+	}
+	return b[size : size+n], isStr, b[size+n:], nil
+}
+
+// replaceCiphertext returns the KMS ciphertext b with its key
+// version and ciphertext replaced. All other fields of b, and
+// their order, are preserved.
+func replaceCiphertext(b []byte, version int, ciphertext []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if _, err := dec.Token(); err != nil { // Opening '{'
+		return nil, err
+	}
+
+	var (
+		buf     bytes.Buffer
+		written = map[string]bool{}
+	)
+	writeField := func(name string, value any) error {
+		v, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if len(written) > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(name)
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+		written[name] = true
+		return nil
+	}
+
+	buf.WriteByte('{')
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		name, _ := t.(string)
+
+		var value json.RawMessage
+		if err = dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		switch name {
+		case "version":
+			if !written[name] {
+				err = writeField(name, version)
+			}
+		case "ciphertext":
+			if !written["version"] {
+				if err = writeField("version", version); err != nil {
+					return nil, err
+				}
+			}
+			err = writeField(name, ciphertext)
+		default:
+			err = writeField(name, value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Blob is a stored ciphertext.
+type Blob struct {
+	// ID identifies the blob within its source. For example,
+	// the file path or the database row ID.
+	ID string
+
+	// Data is the ciphertext.
+	Data []byte
+}
+
+// Invalid describes a blob whose ciphertext header could
+// not be parsed or, when rewrapping, whose key status could
+// not be fetched.
+type Invalid struct {
+	ID  string // The blob ID
+	Err error  // The parsing error
+}
+
+// Report is the result of a scan.
+type Report struct {
+	// Total is the number of scanned blobs.
+	Total int
+
+	// Keys contains the number of ciphertexts per
+	// key version.
+	Keys map[KeyVersion]int
+
+	// Invalid contains all blobs whose ciphertext
+	// header could not be parsed. When rewrapping, it
+	// also contains all blobs whose key status could
+	// not be fetched.
+	Invalid []Invalid
+}
+
+// Scan parses the ciphertext headers of all blobs and returns
+// a Report containing the number of ciphertexts per key version.
+//
+// Blobs that cannot be parsed are listed in Report.Invalid. If
+// reading the blobs fails, Scan returns the partial report and
+// the error.
+func Scan(blobs iter.Seq2[Blob, error]) (*Report, error) {
+	report := &Report{
+		Keys: map[KeyVersion]int{},
+	}
+	for blob, err := range blobs {
+		if err != nil {
+			return report, err
+		}
+
+		report.Total++
+		h, err := ParseHeader(blob.Data)
+		if err != nil {
+			report.Invalid = append(report.Invalid, Invalid{ID: blob.ID, Err: err})
+			continue
+		}
+		report.Keys[KeyVersion(h)]++
+	}
+	return report, nil
+}
+
+// DependsOn reports whether any scanned ciphertext depends
+// on the KMS key version.
+func (r *Report) DependsOn(name string, version int) bool {
+	return r.Keys[KeyVersion{Format: FormatKMS, Name: name, Version: version}] > 0
+}
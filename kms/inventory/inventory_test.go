@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package inventory
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestParseHeader(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range parseHeaderTests {
+		h, err := ParseHeader([]byte(test.Ciphertext))
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: should have failed to parse ciphertext", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to parse ciphertext: %v", i, err)
+		}
+		if h != test.Header {
+			t.Fatalf("Test %d: header mismatch: got '%v' - want '%v'", i, h, test.Header)
+		}
+	}
+}
+
+var parseHeaderTests = []struct {
+	Ciphertext string
+	Header     Header
+	ShouldFail bool
+}{
+	{ // 0
+		Ciphertext: `{"keyid":"my-key","version":2,"ciphertext":"AAECAw=="}`,
+		Header:     Header{Format: FormatKMS, Name: "my-key", Version: 2},
+	},
+	{ // 1
+		Ciphertext: `{"keyid":"my-key","ciphertext":"AAECAw=="}`,
+		Header:     Header{Format: FormatKMS, Name: "my-key"},
+	},
+	{ // 2
+		Ciphertext: `{"aead":"AES-256-GCM-HMAC-SHA-256","id":"66687aadf862bd776c8fc18b8e9f8e20","iv":"AAEC","nonce":"AAEC","bytes":"AAEC"}`,
+		Header:     Header{Format: FormatKES, Name: "66687aadf862bd776c8fc18b8e9f8e20"},
+	},
+	{Ciphertext: `{"keyid":"","ciphertext":"AAECAw=="}`, ShouldFail: true},                    // 3
+	{Ciphertext: `{"keyid":"my-key","version":-1,"ciphertext":"AA=="}`, ShouldFail: true},     // 4
+	{Ciphertext: `{"keyid":"my-key"}`, ShouldFail: true},                                      // 5
+	{Ciphertext: `{"aead":"AES-256-GCM-HMAC-SHA-256","bytes":"AAEC"}`, ShouldFail: true},      // 6
+	{Ciphertext: `{"keyid":"my-key","aead":"AES-256","ciphertext":"AA=="}`, ShouldFail: true}, // 7
+	{Ciphertext: `{}`, ShouldFail: true},                                                      // 8
+	{Ciphertext: "\x00\x01\x02", ShouldFail: true},                                            // 9
+	{ // 10
+		Ciphertext: "\x95\xb8AES-256-GCM-HMAC-SHA-256\xd9\x2066687aadf862bd776c8fc18b8e9f8e20\xc4\x03\x00\x01\x02\xc4\x03\x00\x01\x02\xc4\x03\x00\x01\x02",
+		Header:     Header{Format: FormatKES, Name: "66687aadf862bd776c8fc18b8e9f8e20"},
+	},
+	{Ciphertext: "\x95\xb8AES-256-GCM-HMAC-SHA-256\xd9\x2066687aadf862bd776c8fc18b8e9f8e20\xc4\x03\x00\x01\x02\xc4\x03\x00\x01\x02\xc4\x03\x00", ShouldFail: true}, // 11
+	{Ciphertext: "\x95\xb8AES-256-GCM-HMAC-SHA-256\xc4\x02id\xc4\x03\x00\x01\x02\xc4\x03\x00\x01\x02\xc4\x03\x00\x01\x02", ShouldFail: true},                       // 12
+}
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	blobs := []string{
+		`{"keyid":"my-key","version":1,"ciphertext":"AAECAw=="}`,
+		`{"keyid":"my-key","version":2,"ciphertext":"AAECAw=="}`,
+		`{"keyid":"my-key","version":2,"ciphertext":"BAUGBw=="}`,
+		`{"keyid":"other-key","version":1,"ciphertext":"AAECAw=="}`,
+		`{"aead":"ChaCha20Poly1305","id":"key-id","iv":"AAEC","nonce":"AAEC","bytes":"AAEC"}`,
+		`{"keyid":"my-key"}`,
+	}
+	fsys := fstest.MapFS{}
+	for i, blob := range blobs {
+		fsys["data/"+string(rune('a'+i))] = &fstest.MapFile{Data: []byte(blob)}
+	}
+
+	for name, report := range map[string]func() (*Report, error){
+		"stream": func() (*Report, error) { return Scan(ReadStream(strings.NewReader(strings.Join(blobs, "\n")))) },
+		"dir":    func() (*Report, error) { return Scan(ReadDir(fsys, "data")) },
+	} {
+		r, err := report()
+		if err != nil {
+			t.Fatalf("%s: failed to scan blobs: %v", name, err)
+		}
+		if r.Total != len(blobs) {
+			t.Fatalf("%s: got %d blobs - want %d", name, r.Total, len(blobs))
+		}
+		if len(r.Invalid) != 1 {
+			t.Fatalf("%s: got %d invalid blobs - want 1", name, len(r.Invalid))
+		}
+		if n := r.Keys[KeyVersion{Format: FormatKMS, Name: "my-key", Version: 2}]; n != 2 {
+			t.Fatalf("%s: got %d ciphertexts for 'my-key' version 2 - want 2", name, n)
+		}
+		if n := r.Keys[KeyVersion{Format: FormatKES, Name: "key-id"}]; n != 1 {
+			t.Fatalf("%s: got %d ciphertexts for KES key 'key-id' - want 1", name, n)
+		}
+		if !r.DependsOn("other-key", 1) || r.DependsOn("other-key", 2) {
+			t.Fatalf("%s: invalid key version dependencies", name)
+		}
+	}
+
+	if _, err := Scan(ReadStream(strings.NewReader(`{"keyid":`))); err == nil {
+		t.Fatal("Scan should have failed on malformed stream")
+	}
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"iter"
+	"time"
+
+	"github.com/openstor/kms-go/kms"
+)
+
+// errNoKeyVersion is returned for KMS ciphertexts that
+// cannot be rewrapped since they contain no key version.
+var errNoKeyVersion = errors.New("inventory: KMS ciphertext has no key version")
+
+// Rewrapper re-encrypts KMS ciphertexts with the latest
+// version of their key. Once no ciphertext depends on an
+// older key version anymore, it can be retired.
+//
+// The Rewrapper sends the ciphertexts in batches and pauses
+// between batches to limit the load on the KMS cluster.
+type Rewrapper struct {
+	// Client is the KMS client used to decrypt and
+	// re-encrypt ciphertexts.
+	Client *kms.Client
+
+	// Enclave is the enclave containing the keys.
+	Enclave string
+
+	// BatchSize is the max. number of ciphertexts sent
+	// to the KMS within one request. If <= 0, defaults
+	// to 100.
+	BatchSize int
+
+	// Interval is the pause between two batches.
+	// If 0, batches are sent without pause.
+	Interval time.Duration
+
+	// AssociatedData is an optional function returning the
+	// associated data the blob's ciphertext is bound to.
+	AssociatedData func(Blob) []byte
+
+	// Write persists the re-encrypted ciphertext of the blob,
+	// replacing the existing one. The ciphertext is in the
+	// same format as the original one.
+	Write func(context.Context, Blob) error
+}
+
+// Rewrap re-encrypts all KMS ciphertexts that haven't been
+// encrypted with the latest version of their key. KES
+// ciphertexts and blobs that cannot be parsed are skipped.
+// Rewrapped ciphertexts keep their format. Only their key
+// version and ciphertext are replaced.
+//
+// KMS ciphertexts without a key version cannot be rewrapped
+// since the key version they depend on is unknown. They are
+// listed in Report.Invalid.
+//
+// It returns a Report of all blobs, as Scan does, describing
+// the key versions the ciphertexts depend on after rewrapping.
+// Ciphertexts whose key status cannot be fetched, for example
+// because the key has been deleted, are not rewrapped and
+// listed in Report.Invalid as well. If rewrapping fails,
+// Rewrap returns the partial report and the error. Ciphertexts
+// of the current batch are counted as not rewrapped.
+func (r *Rewrapper) Rewrap(ctx context.Context, blobs iter.Seq2[Blob, error]) (*Report, error) {
+	type Item struct {
+		Blob       Blob
+		Header     Header
+		Ciphertext []byte
+	}
+
+	batchSize := r.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	var (
+		report = &Report{Keys: map[KeyVersion]int{}}
+		latest = map[string]int{}   // Cache of latest key versions
+		failed = map[string]error{} // Cache of failed key status requests
+		batch  = make([]Item, 0, batchSize)
+		sent   bool
+	)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if sent && r.Interval > 0 {
+			timer := time.NewTimer(r.Interval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return context.Cause(ctx)
+			case <-timer.C:
+			}
+		}
+		sent = true
+
+		decReqs := make([]*kms.DecryptRequest, 0, len(batch))
+		for _, item := range batch {
+			req := &kms.DecryptRequest{
+				Name:       item.Header.Name,
+				Version:    item.Header.Version,
+				Ciphertext: item.Ciphertext,
+			}
+			if r.AssociatedData != nil {
+				req.AssociatedData = r.AssociatedData(item.Blob)
+			}
+			decReqs = append(decReqs, req)
+		}
+		plaintexts, err := r.Client.Decrypt(ctx, r.Enclave, decReqs...)
+		if err != nil {
+			return err
+		}
+
+		encReqs := make([]*kms.EncryptRequest, 0, len(batch))
+		for i, item := range batch {
+			encReqs = append(encReqs, &kms.EncryptRequest{
+				Name:           item.Header.Name,
+				Plaintext:      plaintexts[i].Plaintext,
+				AssociatedData: decReqs[i].AssociatedData,
+			})
+		}
+		ciphertexts, err := r.Client.Encrypt(ctx, r.Enclave, encReqs...)
+		for _, p := range plaintexts {
+			clear(p.Plaintext)
+		}
+		if err != nil {
+			return err
+		}
+
+		for i, item := range batch {
+			data, err := replaceCiphertext(bytes.TrimSpace(item.Blob.Data), ciphertexts[i].Version, ciphertexts[i].Ciphertext)
+			if err != nil {
+				return err
+			}
+			if err = r.Write(ctx, Blob{ID: item.Blob.ID, Data: data}); err != nil {
+				return err
+			}
+
+			report.Keys[KeyVersion(item.Header)]--
+			report.Keys[KeyVersion{Format: FormatKMS, Name: item.Header.Name, Version: ciphertexts[i].Version}]++
+			if report.Keys[KeyVersion(item.Header)] == 0 {
+				delete(report.Keys, KeyVersion(item.Header))
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for blob, err := range blobs {
+		if err != nil {
+			return report, err
+		}
+
+		report.Total++
+		var h Header
+		ciphertext, err := parseCiphertext(blob.Data, &h)
+		if err != nil {
+			report.Invalid = append(report.Invalid, Invalid{ID: blob.ID, Err: err})
+			continue
+		}
+		report.Keys[KeyVersion(h)]++
+		if h.Format != FormatKMS {
+			continue
+		}
+		if h.Version == 0 {
+			// Without a key version, the KMS would decrypt the
+			// ciphertext with the latest key version instead of
+			// the one it has been encrypted with.
+			report.Invalid = append(report.Invalid, Invalid{ID: blob.ID, Err: errNoKeyVersion})
+			continue
+		}
+
+		if err, ok := failed[h.Name]; ok {
+			report.Invalid = append(report.Invalid, Invalid{ID: blob.ID, Err: err})
+			continue
+		}
+		version, ok := latest[h.Name]
+		if !ok {
+			status, err := r.Client.KeyStatus(ctx, r.Enclave, &kms.KeyStatusRequest{Name: h.Name})
+			if err != nil {
+				if ctx.Err() != nil {
+					return report, err
+				}
+				failed[h.Name] = err
+				report.Invalid = append(report.Invalid, Invalid{ID: blob.ID, Err: err})
+				continue
+			}
+			version = status[0].Version
+			latest[h.Name] = version
+		}
+		if h.Version >= version {
+			continue
+		}
+
+		batch = append(batch, Item{Blob: blob, Header: h, Ciphertext: ciphertext})
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return report, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return report, err
+	}
+	return report, nil
+}
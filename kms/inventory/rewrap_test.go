@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/kmstest"
+)
+
+func TestRewrapper_Rewrap(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := kmstest.NewServer(nil)
+	defer server.Close()
+
+	client := kmstest.NewClient(t, server)
+	if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "my-enclave"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	for _, name := range []string{"my-key", "deleted-key"} {
+		if err := client.CreateKey(ctx, "my-enclave", &kms.CreateKeyRequest{Name: name}); err != nil {
+			t.Fatalf("Failed to create key '%s': %v", name, err)
+		}
+	}
+
+	encrypt := func(name, plaintext string) []byte {
+		resp, err := client.Encrypt(ctx, "my-enclave", &kms.EncryptRequest{
+			Name:           name,
+			Plaintext:      []byte(plaintext),
+			AssociatedData: []byte(plaintext),
+		})
+		if err != nil {
+			t.Fatalf("Failed to encrypt with key '%s': %v", name, err)
+		}
+		data, err := json.Marshal(kmsCiphertext{KeyID: name, Version: resp[0].Version, Ciphertext: resp[0].Ciphertext})
+		if err != nil {
+			t.Fatalf("Failed to encode ciphertext: %v", err)
+		}
+		return data
+	}
+
+	var blobs []Blob
+	for i := range 5 {
+		id := "blob-" + strconv.Itoa(i)
+		blobs = append(blobs, Blob{ID: id, Data: encrypt("my-key", id)})
+	}
+	blobs = append(blobs,
+		Blob{ID: "deleted-1", Data: encrypt("deleted-key", "deleted-1")},
+		Blob{ID: "deleted-2", Data: encrypt("deleted-key", "deleted-2")},
+		Blob{ID: "kes", Data: []byte(`{"aead":"ChaCha20Poly1305","id":"key-id","iv":"AAEC","nonce":"AAEC","bytes":"AAEC"}`)},
+		Blob{ID: "invalid", Data: []byte(`{"keyid":"my-key"}`)},
+		Blob{ID: "unversioned", Data: []byte(`{"keyid":"my-key","ciphertext":"AAECAw=="}`)},
+	)
+	// Fields unknown to the Rewrapper are preserved.
+	blobs[0].Data = append(blobs[0].Data[:len(blobs[0].Data)-1], `,"bucket":"my-bucket"}`...)
+	if err := client.CreateKey(ctx, "my-enclave", &kms.CreateKeyRequest{Name: "my-key", AddVersion: true}); err != nil {
+		t.Fatalf("Failed to add key version: %v", err)
+	}
+	blobs = append(blobs, Blob{ID: "latest", Data: encrypt("my-key", "latest")})
+	if err := client.DeleteKey(ctx, "my-enclave", &kms.DeleteKeyRequest{Name: "deleted-key"}); err != nil {
+		t.Fatalf("Failed to delete key: %v", err)
+	}
+
+	const Interval = 50 * time.Millisecond
+	var (
+		written = map[string][]byte{}
+		times   []time.Time
+	)
+	r := &Rewrapper{
+		Client:         client,
+		Enclave:        "my-enclave",
+		BatchSize:      2,
+		Interval:       Interval,
+		AssociatedData: func(b Blob) []byte { return []byte(b.ID) },
+		Write: func(_ context.Context, b Blob) error {
+			written[b.ID] = b.Data
+			times = append(times, time.Now())
+			return nil
+		},
+	}
+	start := time.Now()
+	report, err := r.Rewrap(ctx, func(yield func(Blob, error) bool) {
+		for _, b := range blobs {
+			if !yield(b, nil) {
+				return
+			}
+		}
+	})
+	if err != nil {
+		t.Fatalf("Failed to rewrap blobs: %v", err)
+	}
+
+	if report.Total != len(blobs) {
+		t.Fatalf("Total mismatch: got %d - want %d", report.Total, len(blobs))
+	}
+	if len(report.Invalid) != 4 || report.Invalid[0].ID != "deleted-1" || report.Invalid[1].ID != "deleted-2" || report.Invalid[3].ID != "unversioned" {
+		t.Fatalf("Invalid blobs mismatch: got %v", report.Invalid)
+	}
+	if !errors.Is(report.Invalid[0].Err, kms.ErrKeyNotFound) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", report.Invalid[0].Err, kms.ErrKeyNotFound)
+	}
+	if !errors.Is(report.Invalid[3].Err, errNoKeyVersion) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", report.Invalid[3].Err, errNoKeyVersion)
+	}
+	if report.DependsOn("my-key", 1) || report.Keys[KeyVersion{Format: FormatKMS, Name: "my-key", Version: 2}] != 6 {
+		t.Fatalf("Key versions mismatch: got %v", report.Keys)
+	}
+
+	if len(written) != 5 {
+		t.Fatalf("Written blobs mismatch: got %d - want %d", len(written), 5)
+	}
+	if !bytes.HasPrefix(written["blob-0"], []byte(`{"keyid":"my-key","version":2,"ciphertext":`)) || !bytes.HasSuffix(written["blob-0"], []byte(`,"bucket":"my-bucket"}`)) {
+		t.Fatalf("Rewrapped blob has not kept its format: got '%s'", written["blob-0"])
+	}
+	for id, data := range written {
+		h, err := ParseHeader(data)
+		if err != nil {
+			t.Fatalf("Failed to parse rewrapped blob '%s': %v", id, err)
+		}
+		if h.Version != 2 {
+			t.Fatalf("Blob '%s': key version mismatch: got %d - want %d", id, h.Version, 2)
+		}
+		var c kmsCiphertext
+		if err = json.Unmarshal(data, &c); err != nil {
+			t.Fatalf("Failed to decode rewrapped blob '%s': %v", id, err)
+		}
+		plaintext, err := client.Decrypt(ctx, "my-enclave", &kms.DecryptRequest{
+			Name:           c.KeyID,
+			Version:        c.Version,
+			Ciphertext:     c.Ciphertext,
+			AssociatedData: []byte(id),
+		})
+		if err != nil {
+			t.Fatalf("Failed to decrypt rewrapped blob '%s': %v", id, err)
+		}
+		if string(plaintext[0].Plaintext) != id {
+			t.Fatalf("Blob '%s': plaintext mismatch: got '%s'", id, plaintext[0].Plaintext)
+		}
+	}
+
+	// 5 blobs in batches of 2 are sent in 3 batches with a pause
+	// between two subsequent batches. Blobs of one batch are
+	// written without a pause.
+	if elapsed := time.Since(start); elapsed < 2*Interval {
+		t.Fatalf("Rewrapping has not been throttled: took %v - want at least %v", elapsed, 2*Interval)
+	}
+	batches := 1
+	for i := 1; i < len(times); i++ {
+		if times[i].Sub(times[i-1]) >= Interval/2 {
+			batches++
+		}
+	}
+	if batches != 3 {
+		t.Fatalf("Batch count mismatch: got %d - want %d", batches, 3)
+	}
+}
+
+func TestRewrapper_Rewrap_Canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := kmstest.NewServer(nil)
+	defer server.Close()
+
+	client := kmstest.NewClient(t, server)
+	if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "my-enclave"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	if err := client.CreateKey(ctx, "my-enclave", &kms.CreateKeyRequest{Name: "my-key"}); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+
+	var blobs []Blob
+	for i := range 3 {
+		resp, err := client.Encrypt(ctx, "my-enclave", &kms.EncryptRequest{Name: "my-key", Plaintext: []byte{byte(i)}})
+		if err != nil {
+			t.Fatalf("Failed to encrypt: %v", err)
+		}
+		data, _ := json.Marshal(kmsCiphertext{KeyID: "my-key", Version: resp[0].Version, Ciphertext: resp[0].Ciphertext})
+		blobs = append(blobs, Blob{ID: strconv.Itoa(i), Data: data})
+	}
+	if err := client.CreateKey(ctx, "my-enclave", &kms.CreateKeyRequest{Name: "my-key", AddVersion: true}); err != nil {
+		t.Fatalf("Failed to add key version: %v", err)
+	}
+
+	// The context is canceled while the Rewrapper pauses
+	// between the first and second batch.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var written int
+	r := &Rewrapper{
+		Client:    client,
+		Enclave:   "my-enclave",
+		BatchSize: 1,
+		Interval:  time.Hour,
+		Write: func(context.Context, Blob) error {
+			written++
+			cancel()
+			return nil
+		},
+	}
+	report, err := r.Rewrap(ctx, func(yield func(Blob, error) bool) {
+		for _, b := range blobs {
+			if !yield(b, nil) {
+				return
+			}
+		}
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, context.Canceled)
+	}
+	if written != 1 {
+		t.Fatalf("Written blobs mismatch: got %d - want %d", written, 1)
+	}
+	if !report.DependsOn("my-key", 1) || !report.DependsOn("my-key", 2) {
+		t.Fatalf("Key versions mismatch: got %v", report.Keys)
+	}
+}
+
+// kmsCiphertext is the JSON envelope of FormatKMS.
+type kmsCiphertext struct {
+	KeyID      string `json:"keyid"`
+	Version    int    `json:"version,omitempty"`
+	Ciphertext []byte `json:"ciphertext"`
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"iter"
+	"strconv"
+)
+
+// ReadStream returns an iterator over a stream of JSON
+// ciphertexts read from r. The ciphertexts may be separated
+// by whitespace, e.g. one ciphertext per line. The blob ID is
+// the position of the ciphertext within the stream, starting
+// at 0.
+func ReadStream(r io.Reader) iter.Seq2[Blob, error] {
+	return func(yield func(Blob, error) bool) {
+		decoder := json.NewDecoder(r)
+		for n := 0; ; n++ {
+			var raw json.RawMessage
+			if err := decoder.Decode(&raw); err != nil {
+				if !errors.Is(err, io.EOF) {
+					yield(Blob{}, err)
+				}
+				return
+			}
+			if !yield(Blob{ID: strconv.Itoa(n), Data: raw}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ReadDir returns an iterator over all regular files within
+// the directory tree root of fsys. Each file contains one
+// ciphertext. The blob ID is the file path.
+func ReadDir(fsys fs.FS, root string) iter.Seq2[Blob, error] {
+	return func(yield func(Blob, error) bool) {
+		err := fs.WalkDir(fsys, root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+
+			data, err := fs.ReadFile(fsys, path)
+			if err != nil {
+				return err
+			}
+			if !yield(Blob{ID: path, Data: data}, nil) {
+				return fs.SkipAll
+			}
+			return nil
+		})
+		if err != nil {
+			yield(Blob{}, err)
+		}
+	}
+}
+
+// QueryRows returns an iterator over the rows returned by the
+// SQL query. The query must return two columns: the row ID and
+// the ciphertext. For example:
+//
+//	SELECT id, dek FROM objects
+func QueryRows(ctx context.Context, db *sql.DB, query string, args ...any) iter.Seq2[Blob, error] {
+	return func(yield func(Blob, error) bool) {
+		rows, err := db.QueryContext(ctx, query, args...)
+		if err != nil {
+			yield(Blob{}, err)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var blob Blob
+			if err := rows.Scan(&blob.ID, &blob.Data); err != nil {
+				yield(Blob{}, err)
+				return
+			}
+			if !yield(blob, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(Blob{}, err)
+		}
+	}
+}
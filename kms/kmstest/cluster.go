@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kmstest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/cmds"
+	pb "github.com/openstor/kms-go/kms/protobuf"
+)
+
+func clusterStatus(r *request, resp, body []byte) ([]byte, []byte, error) {
+	body, err := cmds.DecodePB(body, cmds.ClusterStatus, new(pb.ClusterStatusRequest))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize("", cmds.ClusterStatus, ""); err != nil {
+		return nil, nil, err
+	}
+
+	c := r.server.cluster
+	status := &pb.ClusterStatusResponse{
+		NodesUp:   map[uint32]*pb.ServerStatusResponse{},
+		NodesDown: map[uint32]string{},
+		NodeRoles: map[uint32]string{},
+	}
+	nodes, roles := map[uint32]string{}, map[uint32]string{}
+	for id, node := range c.nodes {
+		nodes[uint32(id)] = node.Addr
+		roles[uint32(id)] = c.roles[id].String()
+	}
+	for id, node := range c.nodes {
+		status.NodeRoles[uint32(id)] = c.roles[id].String()
+		if node.closed {
+			status.NodesDown[uint32(id)] = node.Addr
+			continue
+		}
+
+		role := "Follower"
+		if id == c.leader {
+			role = "Leader"
+		}
+		status.NodesUp[uint32(id)] = &pb.ServerStatusResponse{
+			Version:    node.conf.Version,
+			APIVersion: "v1",
+			Host:       node.Addr,
+			Role:       role,
+			Commit:     node.commit(),
+			Nodes:      nodes,
+			NodeRoles:  roles,
+			ID:         uint32(id),
+			NodeRole:   c.roles[id].String(),
+			LeaderID:   int64(c.leader),
+		}
+	}
+	resp, err = cmds.EncodePB(resp, cmds.ClusterStatus, status)
+	return resp, body, err
+}
+
+func addNode(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req pb.AddClusterNodeRequest
+	body, err := cmds.DecodePB(body, cmds.ClusterAddNode, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize("", cmds.ClusterAddNode, ""); err != nil {
+		return nil, nil, err
+	}
+	role := kms.NodeVoter
+	if req.Role != "" {
+		if role, err = kms.ParseNodeRole(req.Role); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	node, ok := servers[strings.TrimPrefix(req.Host, "https://")]
+	if !ok {
+		return nil, nil, kms.Error{Code: http.StatusBadGateway, Err: "kmstest: node '" + req.Host + "' is not reachable"}
+	}
+	if node.cluster == r.server.cluster {
+		return nil, nil, kms.Error{Code: http.StatusConflict, Err: "node already exists"}
+	}
+	if len(node.cluster.nodes) > 1 || len(node.cluster.enclaves) > 0 {
+		return nil, nil, kms.Error{Code: http.StatusConflict, Err: "kmstest: node '" + req.Host + "' is not empty"}
+	}
+
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	c := r.server.cluster
+	node.id, node.cluster, node.joinedAt = c.nextID, c, time.Now()
+	c.nodes[node.id] = node
+	c.roles[node.id] = role
+	c.nextID++
+	c.commit++
+
+	return resp, body, nil
+}
+
+func removeNode(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req pb.RemoveClusterNodeRequest
+	body, err := cmds.DecodePB(body, cmds.ClusterRemoveNode, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize("", cmds.ClusterRemoveNode, ""); err != nil {
+		return nil, nil, err
+	}
+
+	c := r.server.cluster
+	for id, node := range c.nodes {
+		if node.Addr != strings.TrimPrefix(req.Host, "https://") {
+			continue
+		}
+		if id == c.leader {
+			return nil, nil, kms.Error{Code: http.StatusConflict, Err: "kmstest: cannot remove cluster leader"}
+		}
+
+		if r.dryRun {
+			return resp, body, nil
+		}
+
+		delete(c.nodes, id)
+		delete(c.roles, id)
+		c.commit++
+		node.cluster = newCluster(node)
+
+		return resp, body, nil
+	}
+	return nil, nil, kms.ErrNodeNotFound
+}
+
+func promoteNode(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req pb.PromoteClusterNodeRequest
+	body, err := cmds.DecodePB(body, cmds.ClusterPromoteNode, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize("", cmds.ClusterPromoteNode, ""); err != nil {
+		return nil, nil, err
+	}
+
+	c := r.server.cluster
+	for id, node := range c.nodes {
+		if node.Addr == strings.TrimPrefix(req.Host, "https://") {
+			if r.dryRun {
+				return resp, body, nil
+			}
+
+			c.roles[id] = kms.NodeVoter
+			c.commit++
+
+			return resp, body, nil
+		}
+	}
+	return nil, nil, kms.ErrNodeNotFound
+}
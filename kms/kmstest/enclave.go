@@ -0,0 +1,889 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kmstest
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/cmds"
+	pb "github.com/openstor/kms-go/kms/protobuf"
+)
+
+// enclave contains the keys, secrets, policies and
+// identities of an enclave.
+type enclave struct {
+	createdAt  time.Time
+	keys       map[string][]keyVersion
+	secrets    map[string]*secret
+	policies   map[string]*kms.Policy
+	identities map[mtls.Identity]identity
+}
+
+// keyVersion is an AES-256 key version.
+type keyVersion struct {
+	key       []byte
+	createdAt time.Time
+	createdBy mtls.Identity
+}
+
+// secret contains all versions of a secret, ordered by
+// version. Deleted versions are not reused.
+type secret struct {
+	versions []secretVersion
+	latest   int // The most recently created version
+}
+
+// secretVersion is a version of a secret.
+type secretVersion struct {
+	version   int
+	value     []byte
+	createdAt time.Time
+	createdBy mtls.Identity
+}
+
+// identity is an identity within an enclave.
+type identity struct {
+	privilege kms.Privilege
+	policy    string
+}
+
+// lookup returns the enclave with the given name.
+func (r *request) lookup(name string) (*enclave, error) {
+	e, ok := r.server.cluster.enclaves[name]
+	if !ok {
+		return nil, kms.ErrEnclaveNotFound
+	}
+	return e, nil
+}
+
+// keyVersion returns the version of the key, or its latest
+// version if version is 0.
+func (e *enclave) keyVersion(name string, version int) (int, *keyVersion, error) {
+	versions, ok := e.keys[name]
+	if !ok {
+		return 0, nil, kms.ErrKeyNotFound
+	}
+	if version == 0 {
+		version = len(versions)
+	}
+	if version < 0 || version > len(versions) {
+		return 0, nil, kms.ErrKeyNotFound
+	}
+	return version, &versions[version-1], nil
+}
+
+// secretVersion returns the version of the secret, or its
+// latest version if version is 0.
+func (e *enclave) secretVersion(name string, version int) (int, *secretVersion, error) {
+	s, ok := e.secrets[name]
+	if !ok {
+		return 0, nil, kms.ErrSecretNotFound
+	}
+	if version == 0 {
+		return len(s.versions) - 1, &s.versions[len(s.versions)-1], nil
+	}
+	for i := range s.versions {
+		if s.versions[i].version == version {
+			return i, &s.versions[i], nil
+		}
+	}
+	return 0, nil, kms.ErrSecretNotFound
+}
+
+func createEnclave(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.CreateEnclaveRequest
+	body, err := cmds.Decode(body, cmds.EnclaveCreate, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize("", cmds.EnclaveCreate, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	c := r.server.cluster
+	if _, ok := c.enclaves[req.Name]; ok {
+		return nil, nil, kms.ErrEnclaveExists
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	c.enclaves[req.Name] = &enclave{
+		createdAt:  time.Now(),
+		keys:       map[string][]keyVersion{},
+		secrets:    map[string]*secret{},
+		policies:   map[string]*kms.Policy{},
+		identities: map[mtls.Identity]identity{},
+	}
+	c.commit++
+	return resp, body, nil
+}
+
+func enclaveStatus(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req pb.EnclaveStatusRequest
+	body, err := cmds.DecodePB(body, cmds.EnclaveStatus, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize("", cmds.EnclaveStatus, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(req.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err = cmds.Encode(resp, cmds.EnclaveStatus, &kms.EnclaveStatusResponse{
+		Name:      req.Name,
+		CreatedAt: e.createdAt,
+	})
+	return resp, body, err
+}
+
+func listEnclaves(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req pb.ListRequest
+	body, err := cmds.DecodePB(body, cmds.EnclaveList, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize("", cmds.EnclaveList, req.Prefix); err != nil {
+		return nil, nil, err
+	}
+
+	enclaves := r.server.cluster.enclaves
+	names := make([]string, 0, len(enclaves))
+	for name := range enclaves {
+		if strings.HasPrefix(name, req.Prefix) && name >= req.ContinueAt {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var list pb.ListEnclavesResponse
+	for i, name := range names {
+		if req.Limit > 0 && i == int(req.Limit) {
+			list.ContinueAt = name
+			break
+		}
+		list.Enclaves = append(list.Enclaves, &pb.EnclaveStatusResponse{
+			Name:      name,
+			CreatedAt: pb.Time(enclaves[name].createdAt),
+		})
+	}
+	resp, err = cmds.EncodePB(resp, cmds.EnclaveList, &list)
+	return resp, body, err
+}
+
+func deleteEnclave(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.DeleteEnclaveRequest
+	body, err := cmds.Decode(body, cmds.EnclaveDelete, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize("", cmds.EnclaveDelete, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err = r.lookup(req.Name); err != nil {
+		return nil, nil, err
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	delete(r.server.cluster.enclaves, req.Name)
+	r.server.cluster.commit++
+	return resp, body, nil
+}
+
+func renameEnclave(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.RenameEnclaveRequest
+	body, err := cmds.Decode(body, cmds.EnclaveRename, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize("", cmds.EnclaveRename, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(req.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := r.server.cluster
+	if _, ok := c.enclaves[req.NewName]; ok {
+		return nil, nil, kms.ErrEnclaveExists
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	delete(c.enclaves, req.Name)
+	c.enclaves[req.NewName] = e
+	c.commit++
+	return resp, body, nil
+}
+
+func createKey(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.CreateKeyRequest
+	body, err := cmds.Decode(body, cmds.KeyCreate, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.KeyCreate, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := e.keys[req.Name]; ok && !req.AddVersion {
+		return nil, nil, kms.ErrKeyExists
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	e.keys[req.Name] = append(e.keys[req.Name], keyVersion{
+		key:       must(randBytes(32)),
+		createdAt: time.Now(),
+		createdBy: r.identity,
+	})
+	r.server.cluster.commit++
+	return resp, body, nil
+}
+
+func deleteKey(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.DeleteKeyRequest
+	body, err := cmds.Decode(body, cmds.KeyDelete, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.KeyDelete, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	version, _, err := e.keyVersion(req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Key versions are identified by their position within the
+	// key ring. Hence, only the latest version can be removed.
+	versions := e.keys[req.Name]
+	if !req.AllVersions && version != len(versions) {
+		return nil, nil, kms.Error{Code: http.StatusNotImplemented, Err: "kmstest: only the latest key version can be deleted"}
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	if req.AllVersions || len(versions) == 1 {
+		delete(e.keys, req.Name)
+	} else {
+		e.keys[req.Name] = versions[:version-1]
+	}
+	r.server.cluster.commit++
+	return resp, body, nil
+}
+
+func keyStatus(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.KeyStatusRequest
+	body, err := cmds.Decode(body, cmds.KeyStatus, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.KeyStatus, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	version, key, err := e.keyVersion(req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err = cmds.Encode(resp, cmds.KeyStatus, &kms.KeyStatusResponse{
+		Name:      req.Name,
+		Version:   version,
+		Type:      kms.AES256,
+		CreatedAt: key.createdAt,
+		CreatedBy: key.createdBy,
+		State:     kms.KeyEnabled,
+		KCV:       kms.KCV(key.key),
+	})
+	return resp, body, err
+}
+
+func encrypt(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.EncryptRequest
+	body, err := cmds.Decode(body, cmds.KeyEncrypt, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.KeyEncrypt, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	version, key, err := e.keyVersion(req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aead := must(cipher.NewGCM(must(aes.NewCipher(key.key))))
+	nonce := must(randBytes(aead.NonceSize()))
+	resp, err = cmds.Encode(resp, cmds.KeyEncrypt, &kms.EncryptResponse{
+		Version:    version,
+		Ciphertext: aead.Seal(nonce, nonce, req.Plaintext, req.AssociatedData),
+	})
+	return resp, body, err
+}
+
+func decrypt(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.DecryptRequest
+	body, err := cmds.Decode(body, cmds.KeyDecrypt, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.KeyDecrypt, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, key, err := e.keyVersion(req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aead := must(cipher.NewGCM(must(aes.NewCipher(key.key))))
+	if len(req.Ciphertext) < aead.NonceSize() {
+		return nil, nil, kms.ErrDecrypt
+	}
+	nonce, ciphertext := req.Ciphertext[:aead.NonceSize()], req.Ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, req.AssociatedData)
+	if err != nil {
+		return nil, nil, kms.ErrDecrypt
+	}
+	resp, err = cmds.Encode(resp, cmds.KeyDecrypt, &kms.DecryptResponse{
+		Plaintext: plaintext,
+	})
+	return resp, body, err
+}
+
+func generateKey(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.GenerateKeyRequest
+	body, err := cmds.Decode(body, cmds.KeyGenerate, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.KeyGenerate, req.Name); err != nil {
+		return nil, nil, err
+	}
+	if req.Length > 1024 {
+		return nil, nil, kms.Error{Code: http.StatusBadRequest, Err: "kmstest: key length exceeds 1024 bytes"}
+	}
+	if req.Length <= 0 {
+		req.Length = 32
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	version, key, err := e.keyVersion(req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aead := must(cipher.NewGCM(must(aes.NewCipher(key.key))))
+	nonce := must(randBytes(aead.NonceSize()))
+	plaintext := must(randBytes(req.Length))
+	resp, err = cmds.Encode(resp, cmds.KeyGenerate, &kms.GenerateKeyResponse{
+		Version:    version,
+		Plaintext:  plaintext,
+		Ciphertext: aead.Seal(nonce, nonce, plaintext, req.AssociatedData),
+	})
+	return resp, body, err
+}
+
+func mac(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.MACRequest
+	body, err := cmds.Decode(body, cmds.KeyMAC, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.KeyMAC, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	version, key, err := e.keyVersion(req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := hmac.New(sha256.New, key.key)
+	h.Write(req.Message)
+	resp, err = cmds.Encode(resp, cmds.KeyMAC, &kms.MACResponse{
+		Version: version,
+		MAC:     h.Sum(nil),
+	})
+	return resp, body, err
+}
+
+func wrappingKey(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.WrappingKeyRequest
+	body, err := cmds.Decode(body, cmds.KeyWrappingKey, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.KeyWrappingKey, ""); err != nil {
+		return nil, nil, err
+	}
+
+	resp, err = cmds.Encode(resp, cmds.KeyWrappingKey, &kms.WrappingKeyResponse{
+		PublicKey: must(x509.MarshalPKIXPublicKey(r.server.cluster.wrappingKey.PublicKey())),
+	})
+	return resp, body, err
+}
+
+func exportKey(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.ExportKeyRequest
+	body, err := cmds.Decode(body, cmds.KeyExport, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.KeyExport, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	version, key, err := e.keyVersion(req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+	pub, err := x509.ParsePKIXPublicKey(req.WrappingKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	wrappingKey, ok := pub.(*ecdh.PublicKey)
+	if !ok || wrappingKey.Curve() != ecdh.X25519() {
+		return nil, nil, kms.Error{Code: http.StatusNotImplemented, Err: "kmstest: wrapping key is not a X25519 public key"}
+	}
+
+	resp, err = cmds.Encode(resp, cmds.KeyExport, &kms.ExportKeyResponse{
+		Name:       req.Name,
+		Version:    version,
+		Type:       kms.AES256,
+		CreatedAt:  key.createdAt,
+		CreatedBy:  key.createdBy,
+		WrappedKey: wrapKey(wrappingKey, key.key),
+	})
+	return resp, body, err
+}
+
+func importWrapKey(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.ImportWrapKeyRequest
+	body, err := cmds.Decode(body, cmds.KeyImportWrap, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.KeyImportWrap, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.Type != 0 && req.Type != kms.AES256 {
+		return nil, nil, kms.Error{Code: http.StatusNotImplemented, Err: "kmstest: only AES256 keys are supported"}
+	}
+	key, err := unwrapKey(r.server.cluster.wrappingKey, req.WrappedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Key versions are identified by their position within the
+	// key ring. Hence, versions can only be imported in order.
+	versions := e.keys[req.Name]
+	if req.Version <= 0 {
+		req.Version = len(versions) + 1
+	}
+	if req.Version <= len(versions) {
+		return nil, nil, kms.ErrKeyExists
+	}
+	if req.Version != len(versions)+1 {
+		return nil, nil, kms.Error{Code: http.StatusNotImplemented, Err: "kmstest: key versions must be imported in order"}
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	v := keyVersion{
+		key:       key,
+		createdAt: req.CreatedAt,
+		createdBy: req.CreatedBy,
+	}
+	if v.createdAt.IsZero() {
+		v.createdAt = time.Now()
+	}
+	if v.createdBy == (mtls.Identity{}) {
+		v.createdBy = r.identity
+	}
+	e.keys[req.Name] = append(versions, v)
+	r.server.cluster.commit++
+	return resp, body, nil
+}
+
+func moveKey(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.MoveKeyRequest
+	body, err := cmds.Decode(body, cmds.KeyMove, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.NewName == "" {
+		req.NewName = req.Name
+	}
+	if err = r.authorize(r.enclave, cmds.KeyMove, req.Name); err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(req.Destination, cmds.KeyMove, req.NewName); err != nil {
+		return nil, nil, err
+	}
+
+	src, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := r.lookup(req.Destination)
+	if err != nil {
+		return nil, nil, err
+	}
+	versions, ok := src.keys[req.Name]
+	if !ok {
+		return nil, nil, kms.ErrKeyNotFound
+	}
+	if _, ok = dst.keys[req.NewName]; ok {
+		return nil, nil, kms.ErrKeyExists
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	delete(src.keys, req.Name)
+	dst.keys[req.NewName] = versions
+	r.server.cluster.commit++
+	return resp, body, nil
+}
+
+func createPolicy(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.CreatePolicyRequest
+	body, err := cmds.Decode(body, cmds.PolicyCreate, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.PolicyCreate, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	e.policies[req.Name] = &kms.Policy{
+		Allow: req.Allow,
+		Deny:  req.Deny,
+	}
+	r.server.cluster.commit++
+	return resp, body, nil
+}
+
+func assignPolicy(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.AssignPolicyRequest
+	body, err := cmds.Decode(body, cmds.PolicyAssign, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.PolicyAssign, req.Policy); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := e.policies[req.Policy]; !ok {
+		return nil, nil, kms.ErrPolicyNotFound
+	}
+	id, ok := e.identities[req.Identity]
+	if !ok {
+		return nil, nil, kms.ErrIdentityNotFound
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	id.policy = req.Policy
+	e.identities[req.Identity] = id
+	r.server.cluster.commit++
+	return resp, body, nil
+}
+
+func createIdentity(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.CreateIdentityRequest
+	body, err := cmds.Decode(body, cmds.IdentityCreate, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.IdentityCreate, req.Identity.String()); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	privilege := req.Privilege
+	if privilege == 0 {
+		privilege = kms.User
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	e.identities[req.Identity] = identity{privilege: privilege}
+	r.server.cluster.commit++
+	return resp, body, nil
+}
+
+func createSecret(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.CreateSecretRequest
+	body, err := cmds.Decode(body, cmds.SecretCreate, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.SecretCreate, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	s, ok := e.secrets[req.Name]
+	if ok && !req.AddVersion {
+		return nil, nil, kms.ErrSecretExists
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	if !ok {
+		s = &secret{}
+		e.secrets[req.Name] = s
+	}
+	s.latest++
+	s.versions = append(s.versions, secretVersion{
+		version:   s.latest,
+		value:     slices.Clone(req.Value),
+		createdAt: time.Now(),
+		createdBy: r.identity,
+	})
+	r.server.cluster.commit++
+	return resp, body, nil
+}
+
+func getSecret(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.SecretRequest
+	body, err := cmds.Decode(body, cmds.SecretGet, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.SecretGet, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, s, err := e.secretVersion(req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err = cmds.Encode(resp, cmds.SecretGet, &kms.SecretResponse{
+		Name:      req.Name,
+		Version:   s.version,
+		Value:     s.value,
+		CreatedAt: s.createdAt,
+		CreatedBy: s.createdBy,
+	})
+	return resp, body, err
+}
+
+func listSecrets(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req pb.ListRequest
+	body, err := cmds.DecodePB(body, cmds.SecretList, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.SecretList, req.Prefix); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := make([]string, 0, len(e.secrets))
+	for name := range e.secrets {
+		if strings.HasPrefix(name, req.Prefix) && name >= req.ContinueAt {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var list pb.ListSecretsResponse
+	for i, name := range names {
+		if req.Limit > 0 && i == int(req.Limit) {
+			list.ContinueAt = name
+			break
+		}
+		_, s, _ := e.secretVersion(name, 0)
+		list.Secrets = append(list.Secrets, &pb.SecretStatusResponse{
+			Name:      name,
+			Version:   uint32(s.version),
+			CreatedAt: pb.Time(s.createdAt),
+			CreatedBy: s.createdBy.String(),
+		})
+	}
+	resp, err = cmds.EncodePB(resp, cmds.SecretList, &list)
+	return resp, body, err
+}
+
+func deleteSecret(r *request, resp, body []byte) ([]byte, []byte, error) {
+	var req kms.DeleteSecretRequest
+	body, err := cmds.Decode(body, cmds.SecretDelete, &req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = r.authorize(r.enclave, cmds.SecretDelete, req.Name); err != nil {
+		return nil, nil, err
+	}
+
+	e, err := r.lookup(r.enclave)
+	if err != nil {
+		return nil, nil, err
+	}
+	i, _, err := e.secretVersion(req.Name, req.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.dryRun {
+		return resp, body, nil
+	}
+
+	s := e.secrets[req.Name]
+	s.versions = slices.Delete(s.versions, i, i+1)
+	if req.AllVersions || len(s.versions) == 0 {
+		delete(e.secrets, req.Name)
+	}
+	r.server.cluster.commit++
+	return resp, body, nil
+}
+
+func randBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// wrapKey encrypts key for the owner of the X25519 public key
+// pub. It derives an AES-256-GCM key from an ephemeral X25519
+// key exchange and returns the ephemeral public key followed
+// by the encrypted key. Since every key exchange produces a
+// new AES key, a zero nonce is sufficient.
+func wrapKey(pub *ecdh.PublicKey, key []byte) []byte {
+	ephemeral := must(ecdh.X25519().GenerateKey(rand.Reader))
+	secret := must(ephemeral.ECDH(pub))
+
+	aead := must(cipher.NewGCM(must(aes.NewCipher(wrapSecret(secret, ephemeral.PublicKey(), pub)))))
+	nonce := make([]byte, aead.NonceSize())
+	return aead.Seal(ephemeral.PublicKey().Bytes(), nonce, key, nil)
+}
+
+// unwrapKey decrypts a key encrypted by wrapKey for priv.
+func unwrapKey(priv *ecdh.PrivateKey, wrappedKey []byte) ([]byte, error) {
+	const PublicKeySize = 32
+	if len(wrappedKey) < PublicKeySize {
+		return nil, kms.ErrDecrypt
+	}
+	ephemeral, err := ecdh.X25519().NewPublicKey(wrappedKey[:PublicKeySize])
+	if err != nil {
+		return nil, kms.ErrDecrypt
+	}
+	secret, err := priv.ECDH(ephemeral)
+	if err != nil {
+		return nil, kms.ErrDecrypt
+	}
+
+	aead := must(cipher.NewGCM(must(aes.NewCipher(wrapSecret(secret, ephemeral, priv.PublicKey())))))
+	nonce := make([]byte, aead.NonceSize())
+	key, err := aead.Open(nil, nonce, wrappedKey[PublicKeySize:], nil)
+	if err != nil || len(key) != 32 {
+		return nil, kms.ErrDecrypt
+	}
+	return key, nil
+}
+
+// wrapSecret derives the AES-256 key used by wrapKey and
+// unwrapKey from the X25519 shared secret and both public
+// keys.
+func wrapSecret(secret []byte, ephemeral, pub *ecdh.PublicKey) []byte {
+	h := sha256.New()
+	h.Write(secret)
+	h.Write(ephemeral.Bytes())
+	h.Write(pub.Bytes())
+	return h.Sum(nil)
+}
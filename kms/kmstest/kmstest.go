@@ -0,0 +1,435 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package kmstest implements fake KMS servers for testing
+// KMS clients and tools built on top of them.
+//
+// Each Server listens on its own local TLS endpoint and starts
+// as empty single-node cluster. Servers can join and leave
+// clusters, just like real KMS servers, but share their state
+// within the test process instead of replicating it. A Server
+// only implements a subset of the KMS API.
+//
+// All servers run within the test process. Package kmstest is
+// not a multi-process harness and does not implement consensus.
+// It only simulates two kinds of cluster behavior: replication
+// lag, using Config.CatchUp, and nodes that are down, using
+// Server.Close. Other failures, like crashing processes, network
+// partitions or leader elections, require real KMS servers.
+//
+// The identity of APIKey is the SysAdmin of all servers. Any
+// other identity has to be created within an enclave. Admins
+// may perform any command within their enclave while users
+// may only perform commands allowed by their assigned policy.
+//
+// Servers support dry-run requests. They validate write
+// commands, including policy and precondition checks, but
+// don't change any state.
+//
+// Servers are usually used together with NewClient:
+//
+//	seed, node := kmstest.NewServer(nil), kmstest.NewServer(nil)
+//	defer seed.Close()
+//	defer node.Close()
+//
+//	client := kmstest.NewClient(t, seed, node)
+package kmstest
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/internal/headers"
+	pb "github.com/openstor/kms-go/kms/protobuf"
+	"google.golang.org/protobuf/proto"
+)
+
+// Version is the default version of a fake KMS server.
+const Version = "2025-01-01T00-00-00Z"
+
+// APIKey is the API key used by clients returned by NewClient.
+const APIKey = "k1:d7cY_5k8HbBGkZpoy2hGmvkxg83QDBXsA_nFXDfTk2E"
+
+// Config is a structure for configuring a fake KMS server.
+type Config struct {
+	// Version is the server version. If empty,
+	// Version is used.
+	Version string
+
+	// FIPS140 indicates whether the server pretends
+	// to run in FIPS 140 mode.
+	FIPS140 bool
+
+	// CatchUp is the time a server takes to replicate
+	// the cluster state after joining a cluster. Until
+	// then, its commit index is behind the leader's.
+	CatchUp time.Duration
+}
+
+// mu guards the state of all servers and clusters. Servers
+// are fakes, so a single lock is good enough.
+var mu sync.Mutex
+
+// servers contains all running servers by address.
+var servers = map[string]*Server{}
+
+// Server is a fake KMS server.
+type Server struct {
+	// Addr is the server address of the form "host:port".
+	Addr string
+
+	server *httptest.Server
+	conf   Config
+
+	// Guarded by mu
+	id       int
+	cluster  *cluster
+	joinedAt time.Time
+	closed   bool
+}
+
+// cluster is the state shared by all servers of a cluster.
+type cluster struct {
+	nodes    map[int]*Server
+	roles    map[int]kms.NodeRole
+	leader   int
+	nextID   int
+	commit   uint64
+	enclaves map[string]*enclave
+
+	// wrappingKey is the cluster's import private key. Exported
+	// key versions are wrapped for its public key.
+	wrappingKey *ecdh.PrivateKey
+}
+
+// NewServer starts and returns a new fake KMS server. The
+// caller should call Close when finished, to shut it down.
+// If conf is nil, the zero Config is used.
+func NewServer(conf *Config) *Server {
+	if conf == nil {
+		conf = &Config{}
+	}
+	s := &Server{conf: *conf}
+	if s.conf.Version == "" {
+		s.conf.Version = Version
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /version", s.handleVersion)
+	mux.HandleFunc("POST /v1/kms/{enclave...}", s.handleKMS)
+
+	s.server = httptest.NewUnstartedServer(mux)
+	s.server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	s.server.StartTLS()
+	s.Addr = s.server.Listener.Addr().String()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	s.cluster = newCluster(s)
+	servers[s.Addr] = s
+	return s
+}
+
+// Close shuts down the server. Other servers report
+// it as down.
+func (s *Server) Close() {
+	mu.Lock()
+	s.closed = true
+	delete(servers, s.Addr)
+	mu.Unlock()
+
+	s.server.Close()
+}
+
+// Certificate returns the server's TLS certificate.
+func (s *Server) Certificate() *x509.Certificate { return s.server.Certificate() }
+
+// Nodes returns the addresses of all servers within
+// the server's cluster.
+func (s *Server) Nodes() []string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	nodes := make([]string, 0, len(s.cluster.nodes))
+	for _, node := range s.cluster.nodes {
+		nodes = append(nodes, node.Addr)
+	}
+	slices.Sort(nodes)
+	return nodes
+}
+
+// NewClient returns a new KMS client for the given servers
+// that authenticates as SysAdmin. It reports any error to tb.
+func NewClient(tb testing.TB, servers ...*Server) *kms.Client {
+	tb.Helper()
+
+	return NewClientWithKey(tb, rootKey, servers...)
+}
+
+// NewClientWithKey returns a new KMS client for the given
+// servers that authenticates with the API key. It reports
+// any error to tb.
+func NewClientWithKey(tb testing.TB, key mtls.PrivateKey, servers ...*Server) *kms.Client {
+	tb.Helper()
+
+	rootCAs := x509.NewCertPool()
+	endpoints := make([]string, 0, len(servers))
+	for _, s := range servers {
+		rootCAs.AddCert(s.Certificate())
+		endpoints = append(endpoints, s.Addr)
+	}
+	client, err := kms.NewClient(&kms.Config{
+		Endpoints: endpoints,
+		APIKey:    key,
+		TLS:       &tls.Config{RootCAs: rootCAs},
+	})
+	if err != nil {
+		tb.Fatalf("kmstest: failed to create client: %v", err)
+	}
+	return client
+}
+
+func newCluster(s *Server) *cluster {
+	s.id = 0
+	s.joinedAt = time.Time{}
+	return &cluster{
+		nodes:    map[int]*Server{0: s},
+		roles:    map[int]kms.NodeRole{0: kms.NodeVoter},
+		leader:   0,
+		nextID:   1,
+		enclaves: map[string]*enclave{},
+
+		wrappingKey: must(ecdh.X25519().GenerateKey(rand.Reader)),
+	}
+}
+
+// commit returns the server's commit index. Servers that have
+// joined the cluster less than their CatchUp ago haven't applied
+// any state changes yet.
+func (s *Server) commit() uint64 {
+	if s.id != s.cluster.leader && time.Since(s.joinedAt) < s.conf.CatchUp {
+		return 0
+	}
+	return s.cluster.commit
+}
+
+func (s *Server) handleVersion(w http.ResponseWriter, _ *http.Request) {
+	writeResponse(w, must(proto.Marshal(&pb.VersionResponse{
+		Version:    s.conf.Version,
+		APIVersion: "v1",
+		Host:       s.Addr,
+		FIPS140:    s.conf.FIPS140,
+	})))
+}
+
+func (s *Server) handleKMS(w http.ResponseWriter, r *http.Request) {
+	identity, err := mtls.PeerIdentity(r.TLS)
+	if err != nil {
+		writeError(w, kms.Error{Code: http.StatusUnauthorized, Err: err.Error()})
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, kms.Error{Code: http.StatusBadRequest, Err: err.Error()})
+		return
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	req := &request{
+		server:   s,
+		enclave:  r.PathValue("enclave"),
+		identity: identity,
+	}
+	if cmd, _ := cmds.Inspect(body); cmd == cmds.DryRun {
+		req.dryRun = true
+
+		resp, err := dryRun(req, body)
+		if err != nil {
+			writeError(w, kms.Error{Code: http.StatusBadRequest, Err: err.Error()})
+			return
+		}
+		w.Header().Set(headers.KMSDryRun, "true")
+		writeResponse(w, resp)
+		return
+	}
+
+	var resp []byte
+	for len(body) > 0 {
+		cmd, _ := cmds.Inspect(body)
+		handler, ok := handlers[cmd]
+		if !ok {
+			writeError(w, kms.Error{Code: http.StatusNotImplemented, Err: "kmstest: command '" + cmd.String() + "' is not supported"})
+			return
+		}
+		if resp, body, err = handler(req, resp, body); err != nil {
+			var kmsErr kms.Error
+			if !errors.As(err, &kmsErr) {
+				kmsErr = kms.Error{Code: http.StatusBadRequest, Err: err.Error()}
+			}
+			writeError(w, kmsErr)
+			return
+		}
+	}
+	writeResponse(w, resp)
+}
+
+// request is the context of a KMS request.
+type request struct {
+	server   *Server
+	enclave  string        // The enclave of the request URL path
+	identity mtls.Identity // The identity of the client
+	dryRun   bool          // Whether commands are only validated
+}
+
+// A handler decodes the next command from body, executes
+// it and appends its response to resp. It returns the
+// response and the remaining body.
+//
+// For dry-run requests, handlers perform all checks but
+// must not change any state.
+type handler func(r *request, resp, body []byte) ([]byte, []byte, error)
+
+var handlers = map[cmds.Command]handler{
+	cmds.ClusterStatus:      clusterStatus,
+	cmds.ClusterAddNode:     addNode,
+	cmds.ClusterRemoveNode:  removeNode,
+	cmds.ClusterPromoteNode: promoteNode,
+
+	cmds.EnclaveCreate: createEnclave,
+	cmds.EnclaveDelete: deleteEnclave,
+	cmds.EnclaveStatus: enclaveStatus,
+	cmds.EnclaveList:   listEnclaves,
+	cmds.EnclaveRename: renameEnclave,
+
+	cmds.KeyCreate:   createKey,
+	cmds.KeyDelete:   deleteKey,
+	cmds.KeyStatus:   keyStatus,
+	cmds.KeyEncrypt:  encrypt,
+	cmds.KeyDecrypt:  decrypt,
+	cmds.KeyGenerate: generateKey,
+	cmds.KeyMAC:      mac,
+	cmds.KeyMove:     moveKey,
+
+	cmds.KeyWrappingKey: wrappingKey,
+	cmds.KeyExport:      exportKey,
+	cmds.KeyImportWrap:  importWrapKey,
+
+	cmds.SecretCreate: createSecret,
+	cmds.SecretGet:    getSecret,
+	cmds.SecretList:   listSecrets,
+	cmds.SecretDelete: deleteSecret,
+
+	cmds.PolicyCreate:   createPolicy,
+	cmds.PolicyAssign:   assignPolicy,
+	cmds.IdentityCreate: createIdentity,
+}
+
+// dryRun validates all commands wrapped by the DRYRUN command
+// in body without executing them. It returns one DryRunResponse
+// per wrapped command. It only returns an error if body is not
+// a single DRYRUN command wrapping a valid sequence of commands.
+func dryRun(r *request, body []byte) ([]byte, error) {
+	var req pb.DryRunRequest
+	rest, err := cmds.DecodePB(body, cmds.DryRun, &req)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("kmstest: dry-run request contains additional commands")
+	}
+
+	var resp []byte
+	for body = req.Body; len(body) > 0; {
+		if len(body) < 6 || uint64(len(body)-6) < uint64(binary.BigEndian.Uint32(body[2:])) {
+			return nil, errors.New("kmstest: invalid command format")
+		}
+		cmd, next := cmds.Command(binary.BigEndian.Uint16(body)), body[6+binary.BigEndian.Uint32(body[2:]):]
+
+		var err error
+		if handler, ok := handlers[cmd]; ok {
+			_, _, err = handler(r, nil, body)
+		} else {
+			err = kms.Error{Code: http.StatusNotImplemented, Err: "kmstest: command '" + cmd.String() + "' is not supported"}
+		}
+
+		if resp, err = cmds.Encode(resp, cmd, &kms.DryRunResponse{Err: err}); err != nil {
+			return nil, err
+		}
+		body = next
+	}
+	return resp, nil
+}
+
+// rootKey is the API key of the SysAdmin identity.
+var rootKey = must(mtls.ParsePrivateKey(APIKey))
+
+// authorize returns an error if the request's identity is
+// not allowed to perform the command cmd with the argument
+// arg within the enclave.
+func (r *request) authorize(enclave string, cmd cmds.Command, arg string) error {
+	if r.identity == rootKey.Identity() {
+		return nil
+	}
+	if cmd.IsCluster() {
+		return kms.ErrPermission
+	}
+
+	e, ok := r.server.cluster.enclaves[enclave]
+	if !ok {
+		return kms.ErrPermission
+	}
+	id, ok := e.identities[r.identity]
+	if !ok {
+		return kms.ErrPermission
+	}
+	if id.privilege == kms.Admin {
+		return nil
+	}
+	policy, ok := e.policies[id.policy]
+	if !ok {
+		return kms.ErrPermission
+	}
+	return policy.Verify(cmd, arg)
+}
+
+func writeResponse(w http.ResponseWriter, body []byte) {
+	w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+	w.Header().Set(headers.ContentLength, strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+func writeError(w http.ResponseWriter, err kms.Error) {
+	body := must(proto.Marshal(&pb.ErrResponse{Message: err.Err}))
+
+	w.Header().Set(headers.ContentType, headers.ContentTypeBinary)
+	w.Header().Set(headers.ContentLength, strconv.Itoa(len(body)))
+	w.WriteHeader(err.Code)
+	w.Write(body)
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
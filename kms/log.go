@@ -57,6 +57,11 @@ type LogRecord struct {
 	//
 	// If empty, no stack trace has been captured.
 	Trace []StackFrame
+
+	// Cursor is the position of the record within the server
+	// log. It can be used as LogRequest.ContinueAt to continue
+	// a log query after this record.
+	Cursor string
 }
 
 // MarshalPB converts the LogRecord into its protobuf representation.
@@ -64,6 +69,7 @@ func (r *LogRecord) MarshalPB(v *pb.LogRecord) error {
 	v.Level = int32(r.Level)
 	v.Message = r.Message
 	v.Time = pb.Time(r.Time)
+	v.Cursor = r.Cursor
 
 	if len(r.Trace) > 0 {
 		v.Trace = make([]*pb.LogRecord_StackFrame, 0, len(r.Trace))
@@ -84,6 +90,7 @@ func (r *LogRecord) UnmarshalPB(v *pb.LogRecord) error {
 	r.Message = v.Message
 	r.Time = v.Time.AsTime()
 	r.Trace = nil
+	r.Cursor = v.Cursor
 
 	if len(v.Trace) > 0 {
 		r.Trace = make([]StackFrame, 0, len(v.Trace))
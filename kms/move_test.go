@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/kmstest"
+)
+
+func TestClient_MoveKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := kmstest.NewServer(nil)
+	defer server.Close()
+
+	client := kmstest.NewClient(t, server)
+	for _, enclave := range []string{"tenant-a", "tenant-b"} {
+		if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: enclave}); err != nil {
+			t.Fatalf("Failed to create enclave '%s': %v", enclave, err)
+		}
+	}
+	for _, name := range []string{"app-1", "app-1", "app-2", "db-1"} {
+		if err := client.CreateKey(ctx, "tenant-a", &kms.CreateKeyRequest{Name: name, AddVersion: true}); err != nil {
+			t.Fatalf("Failed to create key '%s': %v", name, err)
+		}
+	}
+	if err := client.CreateKey(ctx, "tenant-b", &kms.CreateKeyRequest{Name: "app-3"}); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+
+	plaintext := []byte("Hello World")
+	ciphertexts, err := client.Encrypt(ctx, "tenant-a",
+		&kms.EncryptRequest{Name: "app-1", Version: 1, Plaintext: plaintext},
+		&kms.EncryptRequest{Name: "app-1", Version: 2, Plaintext: plaintext},
+	)
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+	before, err := client.KeyStatus(ctx, "tenant-a", &kms.KeyStatusRequest{Name: "app-1"})
+	if err != nil {
+		t.Fatalf("Failed to fetch key status: %v", err)
+	}
+
+	// The user may move app keys out of tenant-a and into
+	// tenant-b, but not db keys.
+	user := newUser(t, client, "tenant-a", "tenant-b")
+	userClient := kmstest.NewClientWithKey(t, user, server)
+	for i, test := range moveKeyTests {
+		c := client
+		if test.AsUser {
+			c = userClient
+		}
+
+		err := c.MoveKey(ctx, test.Enclave, &test.Request)
+		if !errors.Is(err, test.Err) {
+			t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, test.Err)
+		}
+	}
+
+	if _, err = client.KeyStatus(ctx, "tenant-a", &kms.KeyStatusRequest{Name: "app-1"}); !errors.Is(err, kms.ErrKeyNotFound) {
+		t.Fatalf("Moved key still exists in source enclave: %v", err)
+	}
+	if _, err = client.KeyStatus(ctx, "tenant-a", &kms.KeyStatusRequest{Name: "db-1"}); err != nil {
+		t.Fatalf("Key has been moved despite policy: %v", err)
+	}
+	status, err := client.KeyStatus(ctx, "tenant-b", &kms.KeyStatusRequest{Name: "app/key"})
+	if err != nil {
+		t.Fatalf("Failed to fetch status of moved key: %v", err)
+	}
+	if status[0].Version != 2 {
+		t.Fatalf("Key version mismatch: got %d - want %d", status[0].Version, 2)
+	}
+	if !bytes.Equal(status[0].KCV, before[0].KCV) {
+		t.Fatalf("KCV mismatch: got '%x' - want '%x'", status[0].KCV, before[0].KCV)
+	}
+	for i, ciphertext := range ciphertexts {
+		resp, err := client.Decrypt(ctx, "tenant-b", &kms.DecryptRequest{
+			Name:       "app/key",
+			Version:    ciphertext.Version,
+			Ciphertext: ciphertext.Ciphertext,
+		})
+		if err != nil {
+			t.Fatalf("Failed to decrypt ciphertext %d with moved key: %v", i, err)
+		}
+		if !bytes.Equal(resp[0].Plaintext, plaintext) {
+			t.Fatalf("Plaintext mismatch: got '%s' - want '%s'", resp[0].Plaintext, plaintext)
+		}
+	}
+}
+
+var moveKeyTests = []struct {
+	Enclave string
+	Request kms.MoveKeyRequest
+	AsUser  bool
+	Err     error
+}{
+	{ // 0
+		Enclave: "tenant-a",
+		Request: kms.MoveKeyRequest{Name: "app-1", Destination: "tenant-b", NewName: "app/key"},
+	},
+	{ // 1
+		Enclave: "tenant-a",
+		Request: kms.MoveKeyRequest{Name: "app-1", Destination: "tenant-b"},
+		Err:     kms.ErrKeyNotFound,
+	},
+	{ // 2
+		Enclave: "tenant-a",
+		Request: kms.MoveKeyRequest{Name: "app-2", Destination: "tenant-c"},
+		Err:     kms.ErrEnclaveNotFound,
+	},
+	{ // 3
+		Enclave: "tenant-a",
+		Request: kms.MoveKeyRequest{Name: "app-2", Destination: "tenant-b", NewName: "app-3"},
+		Err:     kms.ErrKeyExists,
+	},
+	{ // 4
+		Enclave: "tenant-a",
+		Request: kms.MoveKeyRequest{Name: "db-1", Destination: "tenant-b", NewName: "app-4"},
+		AsUser:  true,
+		Err:     kms.ErrPermission, // Rejected by source enclave
+	},
+	{ // 5
+		Enclave: "tenant-a",
+		Request: kms.MoveKeyRequest{Name: "app-2", Destination: "tenant-b", NewName: "db-2"},
+		AsUser:  true,
+		Err:     kms.ErrPermission, // Rejected by destination enclave
+	},
+	{ // 6
+		Enclave: "tenant-a",
+		Request: kms.MoveKeyRequest{Name: "app-2", Destination: "tenant-b"},
+		AsUser:  true,
+	},
+}
+
+func TestClient_RenameEnclave(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := kmstest.NewServer(nil)
+	defer server.Close()
+
+	client := kmstest.NewClient(t, server)
+	for _, enclave := range []string{"tenant-a", "tenant-b"} {
+		if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: enclave}); err != nil {
+			t.Fatalf("Failed to create enclave '%s': %v", enclave, err)
+		}
+		if err := client.CreateKey(ctx, enclave, &kms.CreateKeyRequest{Name: "app-1"}); err != nil {
+			t.Fatalf("Failed to create key: %v", err)
+		}
+	}
+	user := newUser(t, client, "tenant-a")
+	userClient := kmstest.NewClientWithKey(t, user, server)
+
+	for i, test := range renameEnclaveTests {
+		c := client
+		if test.AsUser {
+			c = userClient
+		}
+
+		err := c.RenameEnclave(ctx, &test.Request)
+		if !errors.Is(err, test.Err) {
+			t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, test.Err)
+		}
+	}
+
+	if _, err := client.EnclaveStatus(ctx, &kms.EnclaveStatusRequest{Name: "tenant-a"}); !errors.Is(err, kms.ErrEnclaveNotFound) {
+		t.Fatalf("Renamed enclave still exists: %v", err)
+	}
+
+	// Keys, policies and identities are renamed together with the enclave.
+	if _, err := client.KeyStatus(ctx, "tenant-c", &kms.KeyStatusRequest{Name: "app-1"}); err != nil {
+		t.Fatalf("Failed to fetch key status within renamed enclave: %v", err)
+	}
+	if err := userClient.MoveKey(ctx, "tenant-c", &kms.MoveKeyRequest{Name: "app-1", Destination: "tenant-c", NewName: "app-2"}); err != nil {
+		t.Fatalf("Failed to move key within renamed enclave: %v", err)
+	}
+}
+
+var renameEnclaveTests = []struct {
+	Request kms.RenameEnclaveRequest
+	AsUser  bool
+	Err     error
+}{
+	{ // 0
+		Request: kms.RenameEnclaveRequest{Name: "tenant-a", NewName: "tenant-c"},
+		AsUser:  true,
+		Err:     kms.ErrPermission,
+	},
+	{ // 1
+		Request: kms.RenameEnclaveRequest{Name: "tenant-a", NewName: "tenant-b"},
+		Err:     kms.ErrEnclaveExists,
+	},
+	{ // 2
+		Request: kms.RenameEnclaveRequest{Name: "tenant-x", NewName: "tenant-y"},
+		Err:     kms.ErrEnclaveNotFound,
+	},
+	{ // 3
+		Request: kms.RenameEnclaveRequest{Name: "tenant-a", NewName: "tenant-c"},
+	},
+}
+
+// newUser creates a new user identity within the given enclaves.
+// The user's policy allows moving keys starting with "app-".
+func newUser(t *testing.T, client *kms.Client, enclaves ...string) mtls.PrivateKey {
+	key, err := mtls.GenerateKeyEdDSA(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate API key: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, enclave := range enclaves {
+		if err = client.CreatePolicy(ctx, enclave, &kms.CreatePolicyRequest{
+			Name:  "move-app",
+			Allow: map[cmds.Command]kms.RuleSet{cmds.KeyMove: {"app-*": {}}},
+		}); err != nil {
+			t.Fatalf("Failed to create policy: %v", err)
+		}
+		if err = client.CreateIdentity(ctx, enclave, &kms.CreateIdentityRequest{
+			Identity:  key.Identity(),
+			Privilege: kms.User,
+		}); err != nil {
+			t.Fatalf("Failed to create identity: %v", err)
+		}
+		if err = client.AssignPolicy(ctx, enclave, &kms.AssignPolicyRequest{
+			Policy:   "move-app",
+			Identity: key.Identity(),
+		}); err != nil {
+			t.Fatalf("Failed to assign policy: %v", err)
+		}
+	}
+	return key
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/internal/pattern"
+)
+
+// Policy is a set of allow and deny rules that determine
+// whether a KMS command is accepted or rejected.
+//
+// Each rule applies a RuleSet to one KMS command. A command
+// is rejected if the RuleSet of any deny rule for the command
+// matches the command's argument, usually a key, policy or
+// identity name. Otherwise, a command is accepted if the
+// RuleSet of any allow rule for the command matches. If no
+// rule matches, the command is rejected as well. Therefore,
+// an empty Policy rejects any command.
+type Policy struct {
+	Allow map[cmds.Command]RuleSet // Set of allow rules
+	Deny  map[cmds.Command]RuleSet // Set of deny rules
+}
+
+// Verify reports whether the command cmd with the argument
+// arg is allowed. It returns no error if:
+//
+//	(1) No deny RuleSet for cmd matches arg *AND*
+//	(2) The allow RuleSet for cmd matches arg.
+//
+// Otherwise, Verify returns ErrPermission.
+func (p *Policy) Verify(cmd cmds.Command, arg string) error {
+	if set, ok := p.Deny[cmd]; ok && set.Match(arg) {
+		return ErrPermission
+	}
+	if set, ok := p.Allow[cmd]; ok && set.Match(arg) {
+		return nil
+	}
+	return ErrPermission
+}
+
+// Match reports whether any pattern of the RuleSet matches s.
+//
+// A pattern that ends with an asterisk ('*') character matches
+// any s that starts with the pattern, without the asterisk.
+// Any other asterisk only matches itself. A plus ('+') that
+// forms an entire namespace segment, i.e. is surrounded by '/'
+// or the pattern boundaries, matches any non-empty sequence of
+// characters within one segment of s, i.e. without a '/'. For
+// example, the pattern "team/+/prod/*" matches "team/app/prod/key"
+// but neither "team/app/dev/prod/key" nor "team//prod/key". A
+// pattern without wildcards only matches s if both are equal.
+// Hence, the pattern "*" matches any s.
+//
+// Segment wildcards are opt-in. Patterns that have been valid
+// before, including patterns with non-trailing asterisks, match
+// the same names as before, unless one of their segments is a
+// single '+'.
+func (r RuleSet) Match(s string) bool {
+	for p := range r {
+		if pattern.Match(p, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import "testing"
+
+func TestRuleSet_Match(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range ruleSetMatchTests {
+		set := RuleSet{test.Pattern: {}}
+		if match := set.Match(test.Name); match != test.Match {
+			t.Fatalf("Test %d: '%s' matching '%s': got %v - want %v", i, test.Pattern, test.Name, match, test.Match)
+		}
+	}
+}
+
+var ruleSetMatchTests = []struct {
+	Pattern string
+	Name    string
+	Match   bool
+}{
+	{Pattern: "", Name: "", Match: false},
+	{Pattern: "*", Name: "", Match: true},
+	{Pattern: "*", Name: "team/app/key", Match: true},
+	{Pattern: "my-key", Name: "my-key", Match: true},
+	{Pattern: "my-key", Name: "my-key-1", Match: false},
+	{Pattern: "my-key*", Name: "my-key-1", Match: true},
+	{Pattern: "team/app/*", Name: "team/app/prod/key", Match: true},
+	{Pattern: "team/app/*", Name: "team/application", Match: false},
+
+	{Pattern: "team/app*", Name: "team/application", Match: true},
+
+	{Pattern: "team/+/prod/*", Name: "team/app/prod/key", Match: true},
+	{Pattern: "team/+/prod/*", Name: "team/app/dev/prod/key", Match: false},
+	{Pattern: "team/+/prod/*", Name: "team//prod/key", Match: false},
+	{Pattern: "team/+/key", Name: "team/app/key", Match: true},
+	{Pattern: "team/+/key", Name: "team/app/key-1", Match: false},
+	{Pattern: "team/+/key", Name: "team/app", Match: false},
+	{Pattern: "team/+", Name: "team/app", Match: true},
+	{Pattern: "team/+", Name: "team/app/key", Match: false},
+	{Pattern: "+/+/key", Name: "team/app/key", Match: true},
+	{Pattern: "+/key", Name: "team/app/key", Match: false},
+	{Pattern: "+", Name: "my-key", Match: true},
+	{Pattern: "+", Name: "", Match: false},
+	{Pattern: "team/+", Name: "team/", Match: false},
+	{Pattern: "+/key", Name: "/key", Match: false},
+
+	// Asterisks and pluses that aren't wildcards only match themselves.
+	{Pattern: "team/*/key", Name: "team/app/key", Match: false},
+	{Pattern: "team/*/key", Name: "team/*/key", Match: true},
+	{Pattern: "team/app-+/key", Name: "team/app-1/key", Match: false},
+	{Pattern: "team/app-+/key", Name: "team/app-+/key", Match: true},
+	{Pattern: "team/+*", Name: "team/+1", Match: true},
+	{Pattern: "team/+*", Name: "team/app", Match: false},
+}
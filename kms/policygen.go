@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"iter"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms/cmds"
+)
+
+// PolicyGenerator generates least-privilege policies from audit
+// records. A generated policy allows exactly the commands an
+// identity has sent within a time window, and nothing more.
+type PolicyGenerator struct {
+	// Identity is the identity for which a policy is generated.
+	// Audit records of other identities are ignored.
+	Identity mtls.Identity
+
+	// Since and Until limit the time window of audit records.
+	// Records before Since or not before Until are ignored.
+	// If zero, the time window is not bounded.
+	Since, Until time.Time
+
+	// Threshold controls when command arguments, like key
+	// names, are collapsed into a single pattern. Arguments
+	// sharing the same stem, the argument up to its last '-',
+	// '_', '.', ':' or '/' character, are replaced by the stem
+	// followed by an asterisk ('*') if the identity has used
+	// at least Threshold distinct arguments with this stem.
+	//
+	// For example, with a Threshold of 2, the key names "app-1"
+	// and "app-2" are collapsed into the pattern "app-*" and
+	// "team/app/db" and "team/app/web" into "team/app/*".
+	//
+	// If Threshold is 0, arguments are never collapsed.
+	Threshold int
+}
+
+// Generate returns a policy that allows all commands of the
+// generator's identity within the time window. It contains
+// one RuleSet per command. Commands that have been rejected
+// with a 403 Forbidden status code are ignored.
+//
+// Commands without an argument, like ClusterStatus, are
+// allowed with the RuleSet {"*"}. An empty argument of a
+// command that has also been sent with arguments is skipped,
+// since no pattern but "*" matches it and allowing "*" would
+// grant access to all arguments.
+func (g *PolicyGenerator) Generate(records iter.Seq[AuditRecord]) *Policy {
+	commands := map[cmds.Command]map[string]struct{}{}
+	for record := range records {
+		if record.Identity != g.Identity || record.StatusCode == http.StatusForbidden {
+			continue
+		}
+		if !g.Since.IsZero() && record.Time.Before(g.Since) {
+			continue
+		}
+		if !g.Until.IsZero() && !record.Time.Before(g.Until) {
+			continue
+		}
+
+		if _, ok := commands[record.Cmd]; !ok {
+			commands[record.Cmd] = map[string]struct{}{}
+		}
+		commands[record.Cmd][record.Arg] = struct{}{}
+	}
+
+	policy := &Policy{
+		Allow: make(map[cmds.Command]RuleSet, len(commands)),
+		Deny:  map[cmds.Command]RuleSet{},
+	}
+	for cmd, args := range commands {
+		if _, ok := args[""]; ok {
+			if len(args) == 1 {
+				policy.Allow[cmd] = RuleSet{"*": {}}
+				continue
+			}
+			delete(args, "")
+		}
+
+		set := make(RuleSet, len(args))
+		for _, pattern := range collapseArgs(args, g.Threshold) {
+			set[pattern] = Rule{}
+		}
+		policy.Allow[cmd] = set
+	}
+	return policy
+}
+
+// PolicyDiff describes the changes required to turn
+// one policy into another.
+type PolicyDiff struct {
+	AddAllow    map[cmds.Command]RuleSet // Allow rules to add
+	RemoveAllow map[cmds.Command]RuleSet // Allow rules to remove
+	AddDeny     map[cmds.Command]RuleSet // Deny rules to add
+	RemoveDeny  map[cmds.Command]RuleSet // Deny rules to remove
+}
+
+// IsEmpty reports whether the PolicyDiff contains no changes.
+func (d *PolicyDiff) IsEmpty() bool {
+	return len(d.AddAllow) == 0 && len(d.RemoveAllow) == 0 && len(d.AddDeny) == 0 && len(d.RemoveDeny) == 0
+}
+
+// Diff returns the changes required to turn the Policy p
+// into o. For example, to compare an identity's current
+// policy with a generated one. The patterns are compared
+// per command.
+func (p *Policy) Diff(o *Policy) PolicyDiff {
+	return PolicyDiff{
+		AddAllow:    diffRuleSets(o.Allow, p.Allow),
+		RemoveAllow: diffRuleSets(p.Allow, o.Allow),
+		AddDeny:     diffRuleSets(o.Deny, p.Deny),
+		RemoveDeny:  diffRuleSets(p.Deny, o.Deny),
+	}
+}
+
+// diffRuleSets returns, per command, all patterns of a
+// that are not in b.
+func diffRuleSets(a, b map[cmds.Command]RuleSet) map[cmds.Command]RuleSet {
+	diff := map[cmds.Command]RuleSet{}
+	for cmd, set := range a {
+		other := b[cmd]
+		for pattern, rule := range set {
+			if _, ok := other[pattern]; ok {
+				continue
+			}
+			if _, ok := diff[cmd]; !ok {
+				diff[cmd] = RuleSet{}
+			}
+			diff[cmd][pattern] = rule
+		}
+	}
+	return diff
+}
+
+// collapseArgs returns a sorted list of patterns matching all
+// args. Args with the same stem are collapsed into a single
+// pattern if there are at least threshold of them.
+//
+// A pattern with a trailing '*' matches any name that starts
+// with the pattern, without the '*'. Refer to RuleSet.Match.
+// Key names may contain '/'-separated namespace segments.
+// Hence, a '/' ends a stem as well.
+func collapseArgs(args map[string]struct{}, threshold int) []string {
+	stems := map[string][]string{}
+	for arg := range args {
+		var stem string
+		if threshold > 0 {
+			if i := strings.LastIndexAny(arg, "-_.:/"); i >= 0 {
+				stem = arg[:i+1]
+			}
+		}
+		stems[stem] = append(stems[stem], arg)
+	}
+
+	patterns := make([]string, 0, len(args))
+	for stem, group := range stems {
+		if stem != "" && len(group) >= threshold {
+			patterns = append(patterns, stem+"*")
+		} else {
+			patterns = append(patterns, group...)
+		}
+	}
+	slices.Sort(patterns)
+	return patterns
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms/cmds"
+)
+
+func TestPolicyGenerator(t *testing.T) {
+	t.Parallel()
+
+	alice, err := mtls.ParseIdentity("h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w")
+	if err != nil {
+		t.Fatalf("Failed to parse identity: %v", err)
+	}
+	bob, err := mtls.ParseIdentity("h1:LLnMSqIkCHnhAO7wNz5E4oe_TPyA8E4oe7c4-KntrdI")
+	if err != nil {
+		t.Fatalf("Failed to parse identity: %v", err)
+	}
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := []AuditRecord{
+		{Time: now, Identity: alice, Cmd: cmds.ClusterStatus, StatusCode: http.StatusOK},
+		{Time: now, Identity: alice, Cmd: cmds.KeyDecrypt, Arg: "app-1", StatusCode: http.StatusOK},
+		{Time: now, Identity: alice, Cmd: cmds.KeyDecrypt, Arg: "app-2", StatusCode: http.StatusOK},
+		{Time: now, Identity: alice, Cmd: cmds.KeyDecrypt, Arg: "db", StatusCode: http.StatusNotFound},
+		{Time: now, Identity: alice, Cmd: cmds.KeyDecrypt, StatusCode: http.StatusBadRequest},
+		{Time: now, Identity: alice, Cmd: cmds.KeyCreate, Arg: "app-3", StatusCode: http.StatusForbidden},
+		{Time: now, Identity: bob, Cmd: cmds.KeyDelete, Arg: "app-1", StatusCode: http.StatusOK},
+		{Time: now.Add(time.Hour), Identity: alice, Cmd: cmds.KeyGenerate, Arg: "app-1", StatusCode: http.StatusOK},
+	}
+
+	for i, test := range policyGeneratorTests {
+		test.Generator.Identity = alice
+		policy := test.Generator.Generate(slices.Values(records))
+
+		if len(policy.Allow) != len(test.Allow) {
+			t.Fatalf("Test %d: got %d allow rules - want %d", i, len(policy.Allow), len(test.Allow))
+		}
+		for cmd, want := range test.Allow {
+			if got := slices.Sorted(maps.Keys(policy.Allow[cmd])); !slices.Equal(got, want) {
+				t.Fatalf("Test %d: rule set mismatch for '%v': got '%v' - want '%v'", i, cmd, got, want)
+			}
+		}
+	}
+}
+
+var policyGeneratorTests = []struct {
+	Generator PolicyGenerator
+	Allow     map[cmds.Command][]string
+}{
+	{ // 0
+		Generator: PolicyGenerator{},
+		Allow: map[cmds.Command][]string{
+			cmds.ClusterStatus: {"*"},
+			cmds.KeyDecrypt:    {"app-1", "app-2", "db"},
+			cmds.KeyGenerate:   {"app-1"},
+		},
+	},
+	{ // 1
+		Generator: PolicyGenerator{Threshold: 2},
+		Allow: map[cmds.Command][]string{
+			cmds.ClusterStatus: {"*"},
+			cmds.KeyDecrypt:    {"app-*", "db"},
+			cmds.KeyGenerate:   {"app-1"},
+		},
+	},
+	{ // 2
+		Generator: PolicyGenerator{Threshold: 2, Until: time.Date(2025, 1, 1, 0, 30, 0, 0, time.UTC)},
+		Allow: map[cmds.Command][]string{
+			cmds.ClusterStatus: {"*"},
+			cmds.KeyDecrypt:    {"app-*", "db"},
+		},
+	},
+}
+
+func TestPolicyDiff(t *testing.T) {
+	t.Parallel()
+
+	current := &Policy{
+		Allow: map[cmds.Command]RuleSet{
+			cmds.KeyDecrypt:  {"*": {}},
+			cmds.KeyGenerate: {"app-*": {}},
+		},
+		Deny: map[cmds.Command]RuleSet{
+			cmds.KeyDecrypt: {"secret-*": {}},
+		},
+	}
+	proposed := &Policy{
+		Allow: map[cmds.Command]RuleSet{
+			cmds.KeyDecrypt:  {"app-*": {}},
+			cmds.KeyGenerate: {"app-*": {}},
+		},
+	}
+
+	diff := current.Diff(proposed)
+	if len(diff.AddAllow) != 1 || !slices.Equal(slices.Collect(maps.Keys(diff.AddAllow[cmds.KeyDecrypt])), []string{"app-*"}) {
+		t.Fatalf("Allow rules to add mismatch: got '%v'", diff.AddAllow)
+	}
+	if len(diff.RemoveAllow) != 1 || !slices.Equal(slices.Collect(maps.Keys(diff.RemoveAllow[cmds.KeyDecrypt])), []string{"*"}) {
+		t.Fatalf("Allow rules to remove mismatch: got '%v'", diff.RemoveAllow)
+	}
+	if len(diff.AddDeny) != 0 {
+		t.Fatalf("Deny rules to add mismatch: got '%v'", diff.AddDeny)
+	}
+	if len(diff.RemoveDeny) != 1 || !slices.Equal(slices.Collect(maps.Keys(diff.RemoveDeny[cmds.KeyDecrypt])), []string{"secret-*"}) {
+		t.Fatalf("Deny rules to remove mismatch: got '%v'", diff.RemoveDeny)
+	}
+	if diff = current.Diff(current); !diff.IsEmpty() {
+		t.Fatalf("Diff of equal policies is not empty: %v", diff)
+	}
+}
@@ -0,0 +1,339 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package policytest implements offline regression tests for
+// KMS policies.
+//
+// A policy test consists of a set of policies and a file with
+// declarative assertions about them. Each line of an assertion
+// file is either empty, a comment starting with '#', or one of:
+//
+//	identity <identity> uses <policy>
+//	identity <identity> may <COMMAND> [<argument>]
+//	identity <identity> may not <COMMAND> [<argument>]
+//	policy <policy> may <COMMAND> [<argument>]
+//	policy <policy> may not <COMMAND> [<argument>]
+//
+// The first form assigns a policy to an identity. The others
+// assert that an identity, resp. a policy, allows or rejects
+// a KMS command with the given argument, usually a key name.
+// An argument containing wildcards refers to all arguments
+// matched by it as policy pattern. For example, "app-*" refers to
+// all arguments starting with "app-" and "team/+/prod/*" to all
+// production keys of any team application. For example:
+//
+//	identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w uses my-app
+//	identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may KEY:ENCRYPT app-*
+//	identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may not KEY:DELETE *
+//
+// Policies are defined as JSON object that maps policy names
+// to allow and deny rules. Each rule maps a KMS command to a
+// RuleSet:
+//
+//	{
+//	  "my-app": {
+//	    "allow": {
+//	      "KEY:ENCRYPT": ["app-*"],
+//	      "KEY:DECRYPT": ["app-*"]
+//	    },
+//	    "deny": {
+//	      "KEY:DELETE": "*"
+//	    }
+//	  }
+//	}
+//
+// Policy tests are usually run as part of "go test":
+//
+//	func TestPolicies(t *testing.T) {
+//		test, err := policytest.Load(os.DirFS("."), "policies.json", "policies.test")
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		test.Check(t)
+//	}
+package policytest
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/internal/pattern"
+)
+
+// Test is a set of policies and assertions about them.
+type Test struct {
+	// Policies contains the policies by name.
+	Policies map[string]*kms.Policy
+
+	// Identities maps identities to the names of
+	// their assigned policies.
+	Identities map[mtls.Identity]string
+
+	// Assertions is the list of assertions evaluated
+	// by the Test.
+	Assertions []Assertion
+}
+
+// Load reads the policies from the policy file and the
+// assertions from the assertion file within fsys.
+func Load(fsys fs.FS, policyFile, assertionFile string) (*Test, error) {
+	f, err := fsys.Open(policyFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	policies, err := ReadPolicies(f)
+	if err != nil {
+		return nil, fmt.Errorf("policytest: %s: %v", policyFile, err)
+	}
+
+	a, err := fsys.Open(assertionFile)
+	if err != nil {
+		return nil, err
+	}
+	defer a.Close()
+
+	test, err := ReadAssertions(a)
+	if err != nil {
+		return nil, fmt.Errorf("policytest: %s: %v", assertionFile, err)
+	}
+	test.Policies = policies
+	return test, nil
+}
+
+// ReadPolicies reads a JSON object mapping policy names to
+// allow and deny rules from r.
+func ReadPolicies(r io.Reader) (map[string]*kms.Policy, error) {
+	var policies map[string]struct {
+		Allow map[cmds.Command]kms.RuleSet `json:"allow"`
+		Deny  map[cmds.Command]kms.RuleSet `json:"deny"`
+	}
+	if err := json.NewDecoder(r).Decode(&policies); err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*kms.Policy, len(policies))
+	for name, p := range policies {
+		m[name] = &kms.Policy{
+			Allow: p.Allow,
+			Deny:  p.Deny,
+		}
+	}
+	return m, nil
+}
+
+// ReadAssertions parses the assertion file from r and returns
+// a Test containing its assertions and policy assignments.
+func ReadAssertions(r io.Reader) (*Test, error) {
+	test := &Test{
+		Identities: map[mtls.Identity]string{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 3 || (fields[0] != "identity" && fields[0] != "policy") {
+			return nil, fmt.Errorf("line %d: invalid assertion '%s'", n, line)
+		}
+
+		var identity mtls.Identity
+		if fields[0] == "identity" {
+			var err error
+			if identity, err = mtls.ParseIdentity(fields[1]); err != nil {
+				return nil, fmt.Errorf("line %d: %v", n, err)
+			}
+		}
+		if fields[0] == "identity" && fields[2] == "uses" {
+			if len(fields) != 4 {
+				return nil, fmt.Errorf("line %d: invalid policy assignment '%s'", n, line)
+			}
+			test.Identities[identity] = fields[3]
+			continue
+		}
+
+		a := Assertion{Line: n, Identity: identity, Allow: true}
+		if fields[0] == "policy" {
+			a.Policy = fields[1]
+		}
+		rest := fields[2:]
+		if rest[0] != "may" {
+			return nil, fmt.Errorf("line %d: invalid assertion '%s': expected 'may' or 'may not'", n, line)
+		}
+		if rest = rest[1:]; len(rest) > 0 && rest[0] == "not" {
+			a.Allow, rest = false, rest[1:]
+		}
+		if len(rest) != 1 && len(rest) != 2 {
+			return nil, fmt.Errorf("line %d: invalid assertion '%s': expected '<COMMAND> [<argument>]'", n, line)
+		}
+		cmd, err := cmds.Parse(rest[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+		a.Cmd = cmd
+		if len(rest) == 2 {
+			a.Arg = rest[1]
+		}
+		test.Assertions = append(test.Assertions, a)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return test, nil
+}
+
+// Assertion is a statement about whether an identity or
+// a policy allows a KMS command.
+type Assertion struct {
+	Line int // The line number within the assertion file
+
+	// Either Identity or Policy is set. If Identity is
+	// set, the assertion refers to the policy assigned
+	// to the identity.
+	Identity mtls.Identity
+	Policy   string
+
+	Allow bool         // Whether the command should be allowed
+	Cmd   cmds.Command // The KMS command
+	Arg   string       // The command argument. May contain wildcards
+}
+
+// String returns the Assertion's textual representation.
+func (a *Assertion) String() string {
+	subject := "policy " + a.Policy
+	if !a.Identity.IsZero() {
+		subject = "identity " + a.Identity.String()
+	}
+	verb := "may"
+	if !a.Allow {
+		verb = "may not"
+	}
+	if a.Arg == "" {
+		return fmt.Sprintf("%s %s %v", subject, verb, a.Cmd)
+	}
+	return fmt.Sprintf("%s %s %v %s", subject, verb, a.Cmd, a.Arg)
+}
+
+// Failure describes an assertion that does not hold.
+type Failure struct {
+	Assertion Assertion
+	Reason    string
+}
+
+// Error returns the line, assertion and reason of the failure.
+func (f *Failure) Error() string {
+	return fmt.Sprintf("line %d: %s: %s", f.Assertion.Line, f.Assertion.String(), f.Reason)
+}
+
+// Evaluate evaluates all assertions and returns the
+// ones that don't hold. It returns no failures if all
+// assertions hold.
+func (t *Test) Evaluate() []Failure {
+	var failures []Failure
+	for _, a := range t.Assertions {
+		name := a.Policy
+		if !a.Identity.IsZero() {
+			var ok bool
+			if name, ok = t.Identities[a.Identity]; !ok {
+				failures = append(failures, Failure{Assertion: a, Reason: "identity has no policy assigned"})
+				continue
+			}
+		}
+		policy, ok := t.Policies[name]
+		if !ok {
+			failures = append(failures, Failure{Assertion: a, Reason: fmt.Sprintf("policy '%s' does not exist", name)})
+			continue
+		}
+
+		if reason := evaluate(policy, &a); reason != "" {
+			failures = append(failures, Failure{
+				Assertion: a,
+				Reason:    fmt.Sprintf("%s by policy '%s'", reason, name),
+			})
+		}
+	}
+	return failures
+}
+
+// Check evaluates all assertions and reports each failure
+// as test error to tb.
+func (t *Test) Check(tb testing.TB) {
+	tb.Helper()
+
+	for _, f := range t.Evaluate() {
+		tb.Error(f.Error())
+	}
+}
+
+// evaluate returns a non-empty reason if the assertion a does
+// not hold for the policy.
+//
+// For assertions about a single argument, it uses Policy.Verify.
+// Assertions about all arguments matched by a pattern hold if all,
+// resp. none, of these arguments are allowed.
+func evaluate(policy *kms.Policy, a *Assertion) string {
+	if !strings.ContainsAny(a.Arg, "*+") {
+		err := policy.Verify(a.Cmd, a.Arg)
+		if a.Allow && err != nil {
+			return "rejected"
+		}
+		if !a.Allow && err == nil {
+			return "allowed"
+		}
+		return ""
+	}
+
+	allow, deny := policy.Allow[a.Cmd], policy.Deny[a.Cmd]
+	if a.Allow {
+		// All arguments are allowed if one allow pattern covers
+		// all of them and no deny pattern overlaps with any of them.
+		var covered bool
+		for p := range allow {
+			if covered = pattern.Subset(p, a.Arg); covered {
+				break
+			}
+		}
+		if !covered {
+			return "not entirely allowed"
+		}
+		for p := range deny {
+			if pattern.Intersect(p, a.Arg) {
+				return fmt.Sprintf("partially rejected by '%s'", p)
+			}
+		}
+		return ""
+	}
+
+	// No argument is allowed if, for every allow pattern overlapping
+	// with the arguments, a deny pattern covers the overlap.
+	for p := range allow {
+		if !pattern.Intersect(p, a.Arg) {
+			continue
+		}
+
+		var denied bool
+		for d := range deny {
+			if denied = pattern.Subset(d, p, a.Arg); denied {
+				break
+			}
+		}
+		if !denied {
+			return fmt.Sprintf("partially allowed by '%s'", p)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package policytest
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const testPolicies = `{
+  "my-app": {
+    "allow": {
+      "KEY:ENCRYPT": ["app-*"],
+      "KEY:DECRYPT": ["app-*"],
+      "KEY:DELETE":  "*"
+    },
+    "deny": {
+      "KEY:DELETE": "app-*"
+    }
+  },
+  "team": {
+    "allow": {
+      "KEY:ENCRYPT": "team/+/prod/*",
+      "KEY:DECRYPT": "team/*"
+    },
+    "deny": {
+      "KEY:DECRYPT": "team/+/prod/secret-*"
+    }
+  },
+  "admin": {
+    "allow": {
+      "KEY:DELETE":     "*",
+      "CLUSTER:STATUS": "*"
+    }
+  }
+}`
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"policies.json": {Data: []byte(testPolicies)},
+	}
+	for i, test := range evaluateTests {
+		fsys["policies.test"] = &fstest.MapFile{Data: []byte(test.Assertions)}
+
+		policyTest, err := Load(fsys, "policies.json", "policies.test")
+		if err != nil {
+			t.Fatalf("Test %d: failed to load policy test: %v", i, err)
+		}
+		failures := policyTest.Evaluate()
+		if len(failures) != len(test.Failures) {
+			t.Fatalf("Test %d: got %d failures - want %d: %v", i, len(failures), len(test.Failures), failures)
+		}
+		for j, f := range failures {
+			if f.Assertion.Line != test.Failures[j] {
+				t.Fatalf("Test %d: failure %d: got line %d - want %d: %v", i, j, f.Assertion.Line, test.Failures[j], f.Error())
+			}
+		}
+	}
+}
+
+var evaluateTests = []struct {
+	Assertions string
+	Failures   []int // Line numbers of failing assertions
+}{
+	{ // 0
+		Assertions: strings.Join([]string{
+			"# my-app may only use app keys",
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w uses my-app",
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may KEY:ENCRYPT app-*",
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may KEY:DECRYPT app-1",
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may not KEY:ENCRYPT db-*",
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may not KEY:DELETE app-*",
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may not CLUSTER:STATUS",
+			"",
+			"policy admin may KEY:DELETE *",
+			"policy admin may CLUSTER:STATUS",
+		}, "\n"),
+	},
+	{ // 1
+		Assertions: strings.Join([]string{
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w uses my-app",
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may KEY:ENCRYPT *",
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may not KEY:DELETE *",
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may KEY:DELETE *",
+			"identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may not KEY:DECRYPT app-1",
+			"identity h1:LLnMSqIkCHnhAO7wNz5E4oe_TPyA8E4oe7c4-KntrdI may CLUSTER:STATUS",
+			"policy unknown may CLUSTER:STATUS",
+		}, "\n"),
+		Failures: []int{2, 3, 4, 5, 6, 7},
+	},
+	{ // 2
+		Assertions: strings.Join([]string{
+			"policy team may KEY:ENCRYPT team/app/prod/*",
+			"policy team may KEY:ENCRYPT team/+/prod/db-*",
+			"policy team may not KEY:ENCRYPT team/app/dev/*",
+			"policy team may not KEY:ENCRYPT team/app/dev/prod/key",
+			"policy team may not KEY:DECRYPT team/+/prod/secret-*",
+			"policy team may not KEY:DECRYPT team/app/prod/secret-db",
+			"policy team may KEY:DECRYPT team/app/dev/*",
+			"policy team may KEY:ENCRYPT team/*",
+			"policy team may KEY:DECRYPT team/+/prod/*",
+			"policy team may not KEY:DECRYPT team/app/*",
+			"policy team may not KEY:ENCRYPT team/+/dev/*",
+		}, "\n"),
+		Failures: []int{8, 9, 10},
+	},
+}
+
+func TestReadAssertions(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range readAssertionsTests {
+		_, err := ReadAssertions(strings.NewReader(test.Assertion))
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: should have failed to parse '%s'", i, test.Assertion)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to parse '%s': %v", i, test.Assertion, err)
+		}
+	}
+}
+
+var readAssertionsTests = []struct {
+	Assertion  string
+	ShouldFail bool
+}{
+	{Assertion: "identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w uses my-app"},
+	{Assertion: "identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w may KEY:ENCRYPT app-*"},
+	{Assertion: "policy my-app may not CLUSTER:STATUS"},
+	{Assertion: "identity X uses my-app", ShouldFail: true},
+	{Assertion: "identity h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w uses", ShouldFail: true},
+	{Assertion: "policy my-app can KEY:ENCRYPT app-*", ShouldFail: true},
+	{Assertion: "policy my-app may KEY:UNKNOWN app-*", ShouldFail: true},
+	{Assertion: "policy my-app may KEY:ENCRYPT team/+/app-*"},
+	{Assertion: "policy my-app may KEY:ENCRYPT app-1 app-2", ShouldFail: true},
+	{Assertion: "key my-app may KEY:ENCRYPT app-*", ShouldFail: true},
+}
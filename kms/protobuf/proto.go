@@ -4,6 +4,7 @@
 
 package protobuf
 
+//go:generate protoc --go_out=../ ./db.proto
 //go:generate protoc --go_out=../ ./log.proto
 //go:generate protoc --go_out=../ ./rule.proto
 //go:generate protoc --go_out=../ ./request.proto
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+)
+
+// ReplicateKey copies all versions of the key name within the enclave
+// from the src to the dst KMS cluster. The enclave must exist on both
+// clusters.
+//
+// The key material never leaves the KMS boundary in plaintext. Each key
+// version is exported by src wrapped with the import public key of dst
+// and imported by dst. Key versions, key types and creation metadata are
+// preserved such that ciphertexts produced by src can be decrypted by dst.
+//
+// Key versions that have been deleted on src are skipped. Key versions
+// that already exist on dst are left unchanged. Hence, ReplicateKey can
+// be called repeatedly to replicate new key versions. However, if an
+// existing key version on dst has a different key check value (KCV)
+// than the same version on src, ReplicateKey returns an error since the
+// keys have diverged. Key versions are not compared if either cluster
+// does not report key check values.
+//
+// The src client identity requires a policy that allows the KEY:EXPORT
+// command explicitly. The dst client identity requires permission to
+// perform the KEY:IMPORTWRAP and KEY:WRAPPINGKEY commands.
+//
+// The returned error is of type *HostError.
+func ReplicateKey(ctx context.Context, src, dst *Client, enclave, name string) error {
+	wrappingKey, err := dst.WrappingKey(ctx, enclave, &WrappingKeyRequest{})
+	if err != nil {
+		return err
+	}
+
+	status, err := src.KeyStatus(ctx, enclave, &KeyStatusRequest{Name: name})
+	if err != nil {
+		return err
+	}
+
+	for version := 1; version <= status[0].Version; version++ {
+		keys, err := src.ExportKey(ctx, enclave, &ExportKeyRequest{
+			Name:        name,
+			Version:     version,
+			WrappingKey: wrappingKey.PublicKey,
+		})
+		if errors.Is(err, ErrKeyNotFound) {
+			continue // Key version has been deleted
+		}
+		if err != nil {
+			return err
+		}
+
+		key := keys[0]
+		err = dst.ImportWrapKey(ctx, enclave, &ImportWrapKeyRequest{
+			Name:       name,
+			Version:    key.Version,
+			Type:       key.Type,
+			WrappedKey: key.WrappedKey,
+			CreatedAt:  key.CreatedAt,
+			CreatedBy:  key.CreatedBy,
+		})
+		if errors.Is(err, ErrKeyExists) {
+			err = compareKeyVersion(ctx, src, dst, enclave, name, key.Version)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compareKeyVersion returns an error if the given key version
+// has different KCVs on src and dst.
+func compareKeyVersion(ctx context.Context, src, dst *Client, enclave, name string, version int) error {
+	req := &KeyStatusRequest{Name: name, Version: version}
+	want, err := src.KeyStatus(ctx, enclave, req)
+	if err != nil {
+		return err
+	}
+	got, err := dst.KeyStatus(ctx, enclave, req)
+	if err != nil {
+		return err
+	}
+
+	if len(want[0].KCV) == 0 || len(got[0].KCV) == 0 {
+		return nil
+	}
+	if !bytes.Equal(want[0].KCV, got[0].KCV) {
+		return hostError("", errors.New("kms: key '"+name+"' version "+strconv.Itoa(version)+" differs between source and destination"))
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/kmstest"
+)
+
+func TestReplicateKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srcServer, dstServer := kmstest.NewServer(nil), kmstest.NewServer(nil)
+	defer srcServer.Close()
+	defer dstServer.Close()
+
+	src, dst := kmstest.NewClient(t, srcServer), kmstest.NewClient(t, dstServer)
+	for _, client := range []*kms.Client{src, dst} {
+		if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "my-enclave"}); err != nil {
+			t.Fatalf("Failed to create enclave: %v", err)
+		}
+	}
+	for i := range 3 {
+		if err := src.CreateKey(ctx, "my-enclave", &kms.CreateKeyRequest{Name: "my-key", AddVersion: i > 0}); err != nil {
+			t.Fatalf("Failed to create key version %d: %v", i+1, err)
+		}
+	}
+
+	ciphertexts := map[int][]byte{}
+	for _, version := range []int{1, 3} {
+		resp, err := src.Encrypt(ctx, "my-enclave", &kms.EncryptRequest{Name: "my-key", Version: version, Plaintext: []byte("Hello World")})
+		if err != nil {
+			t.Fatalf("Failed to encrypt with key version %d: %v", version, err)
+		}
+		ciphertexts[version] = resp[0].Ciphertext
+	}
+
+	if err := kms.ReplicateKey(ctx, src, dst, "my-enclave", "my-key"); err != nil {
+		t.Fatalf("Failed to replicate key: %v", err)
+	}
+	for version, ciphertext := range ciphertexts {
+		resp, err := dst.Decrypt(ctx, "my-enclave", &kms.DecryptRequest{Name: "my-key", Version: version, Ciphertext: ciphertext})
+		if err != nil {
+			t.Fatalf("Failed to decrypt with replicated key version %d: %v", version, err)
+		}
+		if !bytes.Equal(resp[0].Plaintext, []byte("Hello World")) {
+			t.Fatalf("Plaintext mismatch: got '%s' - want '%s'", resp[0].Plaintext, "Hello World")
+		}
+	}
+	for version := 1; version <= 3; version++ {
+		want, err := src.KeyStatus(ctx, "my-enclave", &kms.KeyStatusRequest{Name: "my-key", Version: version})
+		if err != nil {
+			t.Fatalf("Failed to fetch status of key version %d: %v", version, err)
+		}
+		got, err := dst.KeyStatus(ctx, "my-enclave", &kms.KeyStatusRequest{Name: "my-key", Version: version})
+		if err != nil {
+			t.Fatalf("Failed to fetch status of replicated key version %d: %v", version, err)
+		}
+		if !bytes.Equal(got[0].KCV, want[0].KCV) {
+			t.Fatalf("Key version %d: KCV mismatch: got '%x' - want '%x'", version, got[0].KCV, want[0].KCV)
+		}
+		if !got[0].CreatedAt.Equal(want[0].CreatedAt) || got[0].CreatedBy != want[0].CreatedBy {
+			t.Fatalf("Key version %d: metadata mismatch: got '%v:%v' - want '%v:%v'", version, got[0].CreatedAt, got[0].CreatedBy, want[0].CreatedAt, want[0].CreatedBy)
+		}
+	}
+
+	// Replicating again only imports new key versions.
+	if err := src.CreateKey(ctx, "my-enclave", &kms.CreateKeyRequest{Name: "my-key", AddVersion: true}); err != nil {
+		t.Fatalf("Failed to add key version: %v", err)
+	}
+	if err := kms.ReplicateKey(ctx, src, dst, "my-enclave", "my-key"); err != nil {
+		t.Fatalf("Failed to replicate key again: %v", err)
+	}
+	if status, err := dst.KeyStatus(ctx, "my-enclave", &kms.KeyStatusRequest{Name: "my-key"}); err != nil || status[0].Version != 4 {
+		t.Fatalf("Failed to replicate new key version: %v", err)
+	}
+}
+
+func TestReplicateKey_Error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srcServer, dstServer := kmstest.NewServer(nil), kmstest.NewServer(nil)
+	defer srcServer.Close()
+	defer dstServer.Close()
+
+	src, dst := kmstest.NewClient(t, srcServer), kmstest.NewClient(t, dstServer)
+	if err := src.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "my-enclave"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	if err := src.CreateKey(ctx, "my-enclave", &kms.CreateKeyRequest{Name: "my-key"}); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+
+	// The user may access the key but its policy does not allow
+	// the KEY:EXPORT command.
+	key, err := mtls.GenerateKeyEdDSA(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate API key: %v", err)
+	}
+	if err = src.CreatePolicy(ctx, "my-enclave", &kms.CreatePolicyRequest{
+		Name: "key-status",
+		Allow: map[cmds.Command]kms.RuleSet{
+			cmds.KeyStatus:  {"my-*": {}},
+			cmds.KeyEncrypt: {"my-*": {}},
+		},
+	}); err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+	if err = src.CreateIdentity(ctx, "my-enclave", &kms.CreateIdentityRequest{Identity: key.Identity(), Privilege: kms.User}); err != nil {
+		t.Fatalf("Failed to create identity: %v", err)
+	}
+	if err = src.AssignPolicy(ctx, "my-enclave", &kms.AssignPolicyRequest{Policy: "key-status", Identity: key.Identity()}); err != nil {
+		t.Fatalf("Failed to assign policy: %v", err)
+	}
+	user := kmstest.NewClientWithKey(t, key, srcServer)
+
+	for i, test := range []struct {
+		Src, Dst *kms.Client
+		Name     string
+		Err      error
+	}{
+		{Src: src, Dst: dst, Name: "my-key", Err: kms.ErrEnclaveNotFound}, // 0
+		{Src: src, Dst: src, Name: "my-key"},                              // 1
+		{Src: src, Dst: src, Name: "other-key", Err: kms.ErrKeyNotFound},  // 2
+		{Src: user, Dst: src, Name: "my-key", Err: kms.ErrPermission},     // 3
+	} {
+		err := kms.ReplicateKey(ctx, test.Src, test.Dst, "my-enclave", test.Name)
+		if !errors.Is(err, test.Err) {
+			t.Fatalf("Test %d: error mismatch: got '%v' - want '%v'", i, err, test.Err)
+		}
+		if err != nil && kms.AsHostError(err) == nil {
+			t.Fatalf("Test %d: error is not a HostError: %v", i, err)
+		}
+	}
+
+	// A key version that exists on both clusters with
+	// different key material must not be accepted.
+	if err = dst.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "my-enclave"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	if err = dst.CreateKey(ctx, "my-enclave", &kms.CreateKeyRequest{Name: "my-key"}); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	if err = kms.ReplicateKey(ctx, src, dst, "my-enclave", "my-key"); err == nil {
+		t.Fatal("Replicated key onto diverged key version")
+	}
+	if kms.AsHostError(err) == nil {
+		t.Fatalf("Error is not a HostError: %v", err)
+	}
+}
@@ -8,8 +8,12 @@ import (
 	"errors"
 	"io"
 	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"aead.dev/mem"
 	"aead.dev/mtls"
 	"github.com/openstor/kms-go/kms/cmds"
 	pb "github.com/openstor/kms-go/kms/protobuf"
@@ -42,6 +46,19 @@ type Request struct {
 	// multiple encoded commands to be executed by
 	// the KMS.
 	Body []byte
+
+	// DryRun indicates whether the KMS server should only
+	// validate the commands without executing them. The
+	// server authenticates the request and checks policies
+	// and preconditions of each command but does not change
+	// any state. Refer to Client.DryRun.
+	//
+	// The commands are sent wrapped in a DRYRUN command,
+	// such that servers without dry-run support reject the
+	// request. A response is only accepted as dry-run result
+	// if the server confirms the dry-run with the Kms-Dry-Run
+	// response header.
+	DryRun bool
 }
 
 // ListRequest contains generic options for listing elements,
@@ -77,13 +94,32 @@ type ListRequest struct {
 	// and the server limits listing results to a
 	// reasonable max. size.
 	Limit int
+
+	// Delimiter is an optional character that separates the
+	// segments of hierarchical names. For example, '/' for
+	// key names like "team/app/env/purpose".
+	//
+	// If set, elements whose name contains the delimiter
+	// after the Prefix are not listed individually. Instead,
+	// their common prefixes, up to and including the first
+	// delimiter after the Prefix, are returned once each, as
+	// Page.CommonPrefixes. Similar to S3 CommonPrefixes, this
+	// allows browsing a hierarchy one level at a time.
+	//
+	// Currently, only listing keys supports delimiters.
+	Delimiter string
 }
 
 // MarshalPB converts the ListRequest into its protobuf representation.
 func (r *ListRequest) MarshalPB(v *pb.ListRequest) error {
+	if len(r.Delimiter) > 1 {
+		return errors.New("kms: invalid ListRequest: delimiter must be a single character")
+	}
+
 	v.Prefix = r.Prefix
 	v.ContinueAt = r.ContinueAt
 	v.Limit = uint32(max(r.Limit, 0))
+	v.Delimiter = r.Delimiter
 	return nil
 }
 
@@ -92,6 +128,7 @@ func (r *ListRequest) UnmarshalPB(v *pb.ListRequest) error {
 	r.Prefix = v.Prefix
 	r.ContinueAt = v.ContinueAt
 	r.Limit = int(v.Limit)
+	r.Delimiter = v.Delimiter
 	return nil
 }
 
@@ -159,6 +196,13 @@ func (r *ClusterStatusRequest) UnmarshalPB(*pb.ClusterStatusRequest) error { ret
 type ReadDBRequest struct {
 	// Host from which a database snapshot should be taken.
 	Host string
+
+	// Since is an optional commit index. If > 0, the KMS server
+	// returns an incremental snapshot containing all state changes
+	// after the given commit index instead of a full snapshot.
+	// Usually, Since is the ReadDBResponse.Commit of the previous
+	// snapshot. Refer to BackupManifest.
+	Since uint64
 }
 
 // WriteDBRequest contains options for restoring a KMS server's
@@ -167,6 +211,12 @@ type WriteDBRequest struct {
 	// Host to which the database snapshot is sent.
 	Host string
 
+	// Since is the commit index after which an incremental snapshot
+	// contains state changes. If > 0, Body must be an incremental
+	// snapshot and the KMS server applies its state changes on top
+	// of its current database. If 0, Body must be a full snapshot.
+	Since uint64
+
 	// The database snapshot.
 	Body io.Reader
 }
@@ -206,16 +256,99 @@ type AddClusterNodeRequest struct {
 	// Host is the KMS server that should join a cluster.
 	// It must be of the form "host" or "host:port".
 	Host string
+
+	// Role is the role of the KMS server within the cluster.
+	// If not set, the KMS server joins the cluster as voter.
+	Role NodeRole
 }
 
 // MarshalPB converts the AddClusterNodeRequest into its protobuf representation.
 func (r *AddClusterNodeRequest) MarshalPB(v *pb.AddClusterNodeRequest) error {
+	if r.Role != 0 {
+		v.Role = r.Role.String()
+	} else {
+		v.Role = ""
+	}
+
 	v.Host = r.Host
 	return nil
 }
 
 // UnmarshalPB initializes the AddClusterNodeRequest from its protobuf representation.
 func (r *AddClusterNodeRequest) UnmarshalPB(v *pb.AddClusterNodeRequest) error {
+	var role NodeRole
+	if v.Role != "" {
+		var err error
+		if role, err = ParseNodeRole(v.Role); err != nil {
+			return err
+		}
+	}
+
+	r.Host = v.Host
+	r.Role = role
+	return nil
+}
+
+// TransferLeadershipRequest describes to which node the current
+// cluster leader should hand off the cluster leadership.
+type TransferLeadershipRequest struct {
+	// NodeID is the ID of the cluster node that should
+	// become the new cluster leader.
+	NodeID int
+}
+
+// MarshalPB converts the TransferLeadershipRequest into its protobuf representation.
+func (r *TransferLeadershipRequest) MarshalPB(v *pb.TransferLeadershipRequest) error {
+	if r.NodeID < 0 {
+		return errors.New("kms: invalid TransferLeadershipRequest: node ID is negative")
+	}
+
+	v.NodeID = uint32(r.NodeID)
+	return nil
+}
+
+// UnmarshalPB initializes the TransferLeadershipRequest from its protobuf representation.
+func (r *TransferLeadershipRequest) UnmarshalPB(v *pb.TransferLeadershipRequest) error {
+	r.NodeID = int(v.NodeID)
+	return nil
+}
+
+// PromoteClusterNodeRequest describes which learner node to promote
+// to a voter.
+type PromoteClusterNodeRequest struct {
+	// Host is the KMS server that should become a voter.
+	// It must be of the form "host" or "host:port".
+	Host string
+}
+
+// MarshalPB converts the PromoteClusterNodeRequest into its protobuf representation.
+func (r *PromoteClusterNodeRequest) MarshalPB(v *pb.PromoteClusterNodeRequest) error {
+	v.Host = r.Host
+	return nil
+}
+
+// UnmarshalPB initializes the PromoteClusterNodeRequest from its protobuf representation.
+func (r *PromoteClusterNodeRequest) UnmarshalPB(v *pb.PromoteClusterNodeRequest) error {
+	r.Host = v.Host
+	return nil
+}
+
+// DemoteClusterNodeRequest describes which voter node to demote
+// to a learner.
+type DemoteClusterNodeRequest struct {
+	// Host is the KMS server that should become a learner.
+	// It must be of the form "host" or "host:port".
+	Host string
+}
+
+// MarshalPB converts the DemoteClusterNodeRequest into its protobuf representation.
+func (r *DemoteClusterNodeRequest) MarshalPB(v *pb.DemoteClusterNodeRequest) error {
+	v.Host = r.Host
+	return nil
+}
+
+// UnmarshalPB initializes the DemoteClusterNodeRequest from its protobuf representation.
+func (r *DemoteClusterNodeRequest) UnmarshalPB(v *pb.DemoteClusterNodeRequest) error {
 	r.Host = v.Host
 	return nil
 }
@@ -240,6 +373,11 @@ type RemoveClusterNodeRequest struct {
 	// re-creates a new single-node cluster definition on restart. This is useful
 	// if the server should be re-purposed or join another cluster.
 	DeleteClusterOnHost bool
+
+	// DryRun indicates whether removing the KMS server is only
+	// validated. If true, the KMS server checks permissions and
+	// preconditions but does not remove the server.
+	DryRun bool
 }
 
 // MarshalPB converts the RemoveClusterNodeRequest into its protobuf representation.
@@ -268,15 +406,66 @@ type EditClusterRequest struct {
 	// from the cluster definition of the KMS server that receives
 	// the request.
 	Remove []int
+
+	// Update is a map of KMS server node IDs to new node addresses
+	// of the form 'host' or 'host:port'. The address of each node
+	// is replaced within the cluster definition of the KMS server
+	// that receives the request. The node IDs remain unchanged.
+	Update map[int]string
+
+	// HeartbeatInterval is the new heartbeat interval of the KMS
+	// server that receives the request. If 0, the heartbeat interval
+	// remains unchanged.
+	HeartbeatInterval time.Duration
+
+	// ElectionTimeout is the new base election timeout of the KMS
+	// server that receives the request. If 0, the election timeout
+	// remains unchanged. If both, HeartbeatInterval and ElectionTimeout,
+	// are set, the ElectionTimeout must be at least twice as long as
+	// the HeartbeatInterval.
+	ElectionTimeout time.Duration
 }
 
 // MarshalPB converts the EditClusterRequest into its protobuf representation.
 func (r *EditClusterRequest) MarshalPB(v *pb.EditClusterRequest) error {
+	if r.HeartbeatInterval < 0 {
+		return errors.New("kms: invalid EditClusterRequest: heartbeat interval is negative")
+	}
+	if r.ElectionTimeout < 0 {
+		return errors.New("kms: invalid EditClusterRequest: election timeout is negative")
+	}
+	if r.HeartbeatInterval > 0 && r.ElectionTimeout > 0 && r.ElectionTimeout < 2*r.HeartbeatInterval {
+		return errors.New("kms: invalid EditClusterRequest: election timeout is less than twice the heartbeat interval")
+	}
+	for id, addr := range r.Update {
+		if id < 0 {
+			return errors.New("kms: invalid EditClusterRequest: node ID is negative")
+		}
+		if addr == "" {
+			return errors.New("kms: invalid EditClusterRequest: node address is empty")
+		}
+		if slices.Contains(r.Remove, id) {
+			return errors.New("kms: invalid EditClusterRequest: node '" + strconv.Itoa(id) + "' is removed and updated")
+		}
+	}
+
 	v.Host = r.Host
 	v.RemoveIDs = make([]uint32, 0, len(r.Remove))
 	for _, id := range r.Remove {
 		v.RemoveIDs = append(v.RemoveIDs, uint32(id))
 	}
+	v.UpdateAddrs = make(map[uint32]string, len(r.Update))
+	for id, addr := range r.Update {
+		v.UpdateAddrs[uint32(id)] = addr
+	}
+	v.HeartbeatInterval = nil
+	if r.HeartbeatInterval > 0 {
+		v.HeartbeatInterval = pb.Duration(r.HeartbeatInterval)
+	}
+	v.ElectionTimeout = nil
+	if r.ElectionTimeout > 0 {
+		v.ElectionTimeout = pb.Duration(r.ElectionTimeout)
+	}
 	return nil
 }
 
@@ -287,6 +476,12 @@ func (r *EditClusterRequest) UnmarshalPB(v *pb.EditClusterRequest) error {
 	for _, id := range v.RemoveIDs {
 		r.Remove = append(r.Remove, int(id))
 	}
+	r.Update = make(map[int]string, len(v.UpdateAddrs))
+	for id, addr := range v.UpdateAddrs {
+		r.Update[int(id)] = addr
+	}
+	r.HeartbeatInterval = v.HeartbeatInterval.AsDuration()
+	r.ElectionTimeout = v.ElectionTimeout.AsDuration()
 	return nil
 }
 
@@ -312,14 +507,44 @@ type LogRequest struct {
 	// The server sends only stack traces for records with an
 	// equal or greater log level.
 	TraceLevel slog.Level
+
+	// Optionally, fetch log records before the given point in time.
+	// If set, the server closes the stream once all records before
+	// Until have been sent.
+	Until time.Time
+
+	// Optionally, the max. number of log records the server sends.
+	// The server closes the stream once Limit records have been sent.
+	// If <= 0, the number of records is not limited.
+	Limit int
+
+	// Descending indicates whether the server sends log records from
+	// newest to oldest, starting at Until or, if empty, the server's
+	// current time. The server closes the stream once the oldest
+	// record since Since has been sent.
+	Descending bool
+
+	// ContinueAt is the cursor of a previously received log record.
+	// If set, the server continues after this record. Paginating
+	// through historical logs requires the same Since, Until and
+	// Descending values for all requests.
+	ContinueAt string
 }
 
 // MarshalPB converts the LogRequest into its protobuf representation.
 func (r *LogRequest) MarshalPB(v *pb.LogRequest) error {
+	if !r.Since.IsZero() && !r.Until.IsZero() && r.Until.Before(r.Since) {
+		return errors.New("kms: invalid LogRequest: until is before since")
+	}
+
 	v.Level = int32(r.Level)
 	v.Message = r.Message
 	v.Since = pb.Time(r.Since)
 	v.TraceLevel = int32(r.TraceLevel)
+	v.Until = pb.Time(r.Until)
+	v.Limit = uint32(max(r.Limit, 0))
+	v.Descending = r.Descending
+	v.ContinueAt = r.ContinueAt
 	return nil
 }
 
@@ -329,6 +554,10 @@ func (r *LogRequest) UnmarshalPB(v *pb.LogRequest) error {
 	r.Message = v.Message
 	r.Since = v.Since.AsTime()
 	r.TraceLevel = slog.Level(v.TraceLevel)
+	r.Until = v.Until.AsTime()
+	r.Limit = int(v.Limit)
+	r.Descending = v.Descending
+	r.ContinueAt = v.ContinueAt
 	return nil
 }
 
@@ -393,6 +622,110 @@ func (r *CreateEnclaveRequest) UnmarshalPB(v *pb.CreateEnclaveRequest) error {
 	return nil
 }
 
+// ExportEnclaveRequest contains options for exporting an enclave
+// as encrypted archive.
+type ExportEnclaveRequest struct {
+	// Name is the name of the enclave to export.
+	Name string
+
+	// ExportKey is an optional 256 bit key used to wrap the
+	// enclave's keys within the archive. If empty, the keys
+	// are wrapped under a cluster key and the archive can only
+	// be imported into the same cluster.
+	//
+	// Applications must keep the export key secret and provide
+	// it when importing the archive.
+	ExportKey []byte
+}
+
+// MarshalPB converts the ExportEnclaveRequest into its protobuf representation.
+func (r *ExportEnclaveRequest) MarshalPB(v *pb.ExportEnclaveRequest) error {
+	if n := len(r.ExportKey); n != 0 && n != 32 {
+		return errors.New("kms: invalid ExportEnclaveRequest: export key must be 256 bits long")
+	}
+
+	v.Name = r.Name
+	v.ExportKey = r.ExportKey
+	return nil
+}
+
+// UnmarshalPB initializes the ExportEnclaveRequest from its protobuf representation.
+func (r *ExportEnclaveRequest) UnmarshalPB(v *pb.ExportEnclaveRequest) error {
+	r.Name = v.Name
+	r.ExportKey = v.ExportKey
+	return nil
+}
+
+// ImportEnclaveRequest contains options for restoring an enclave
+// from an encrypted archive.
+type ImportEnclaveRequest struct {
+	// Name is the name of the enclave to create. If empty, the
+	// enclave is restored under the name within the archive.
+	Name string
+
+	// Archive is the encrypted enclave archive, as returned by
+	// ExportEnclave.
+	Archive []byte
+
+	// ExportKey is the 256 bit key used when exporting the
+	// archive. Empty, if the archive has been exported without
+	// an export key.
+	ExportKey []byte
+}
+
+// MarshalPB converts the ImportEnclaveRequest into its protobuf representation.
+func (r *ImportEnclaveRequest) MarshalPB(v *pb.ImportEnclaveRequest) error {
+	if len(r.Archive) == 0 {
+		return errors.New("kms: invalid ImportEnclaveRequest: archive is empty")
+	}
+	if n := len(r.ExportKey); n != 0 && n != 32 {
+		return errors.New("kms: invalid ImportEnclaveRequest: export key must be 256 bits long")
+	}
+
+	v.Name = r.Name
+	v.Archive = r.Archive
+	v.ExportKey = r.ExportKey
+	return nil
+}
+
+// UnmarshalPB initializes the ImportEnclaveRequest from its protobuf representation.
+func (r *ImportEnclaveRequest) UnmarshalPB(v *pb.ImportEnclaveRequest) error {
+	r.Name = v.Name
+	r.Archive = v.Archive
+	r.ExportKey = v.ExportKey
+	return nil
+}
+
+// RenameEnclaveRequest contains options for renaming an enclave.
+type RenameEnclaveRequest struct {
+	// Name is the name of the enclave to rename.
+	Name string
+
+	// NewName is the new name of the enclave.
+	NewName string
+}
+
+// MarshalPB converts the RenameEnclaveRequest into its protobuf representation.
+func (r *RenameEnclaveRequest) MarshalPB(v *pb.RenameEnclaveRequest) error {
+	if r.Name == "" || r.NewName == "" {
+		return errors.New("kms: invalid RenameEnclaveRequest: enclave name is empty")
+	}
+	if r.Name == r.NewName {
+		return errors.New("kms: invalid RenameEnclaveRequest: new name is equal to current name")
+	}
+
+	v.Name = r.Name
+	v.NewName = r.NewName
+	return nil
+}
+
+// UnmarshalPB initializes the RenameEnclaveRequest from its protobuf representation.
+func (r *RenameEnclaveRequest) UnmarshalPB(v *pb.RenameEnclaveRequest) error {
+	r.Name = v.Name
+	r.NewName = v.NewName
+	return nil
+}
+
 // EnclaveStatusRequest contains options for fetching metadata
 // about an enclave.
 type EnclaveStatusRequest struct {
@@ -416,6 +749,11 @@ func (r *EnclaveStatusRequest) UnmarshalPB(v *pb.EnclaveStatusRequest) error {
 type DeleteEnclaveRequest struct {
 	// Name is the name of the enclave to delete.
 	Name string
+
+	// DryRun indicates whether deleting the enclave is only
+	// validated. If true, the KMS server checks permissions and
+	// preconditions but does not delete the enclave.
+	DryRun bool
 }
 
 // MarshalPB converts the DeleteEnclaveRequest into its protobuf representation.
@@ -522,6 +860,140 @@ func (r *ImportKeyRequest) UnmarshalPB(v *pb.ImportKeyRequest) error {
 	return nil
 }
 
+// ExportKeyRequest contains options for exporting a key version
+// wrapped for another KMS cluster.
+type ExportKeyRequest struct {
+	// Name is the name of the key to export.
+	Name string
+
+	// Version is the key version to export. If <= 0, refers
+	// to the latest key version currently present.
+	Version int
+
+	// WrappingKey is the import public key of the KMS cluster
+	// the key version is exported to. It must be a DER-encoded
+	// PKIX public key, as returned by Client.WrappingKey.
+	//
+	// The plaintext key material never leaves the KMS boundary.
+	// Only the KMS cluster in possession of the corresponding
+	// private key can import the exported key.
+	WrappingKey []byte
+}
+
+// MarshalPB converts the ExportKeyRequest into its protobuf representation.
+func (r *ExportKeyRequest) MarshalPB(v *pb.ExportKeyRequest) error {
+	if len(r.WrappingKey) == 0 {
+		return errors.New("kms: invalid ExportKeyRequest: wrapping key is empty")
+	}
+
+	v.Name = r.Name
+	v.Version = uint32(max(r.Version, 0))
+	v.WrappingKey = r.WrappingKey
+	return nil
+}
+
+// UnmarshalPB initializes the ExportKeyRequest from its protobuf representation.
+func (r *ExportKeyRequest) UnmarshalPB(v *pb.ExportKeyRequest) error {
+	r.Name = v.Name
+	r.Version = int(v.Version)
+	r.WrappingKey = v.WrappingKey
+	return nil
+}
+
+// ImportWrapKeyRequest contains options for importing a key version
+// that has been exported, and wrapped, by another KMS cluster.
+type ImportWrapKeyRequest struct {
+	// Name is the name of the key to import.
+	Name string
+
+	// Version is the key version that is imported. If <= 0,
+	// the imported key becomes the next key version.
+	Version int
+
+	// Type of the imported key. For example, AES256.
+	Type SecretKeyType
+
+	// WrappedKey is the key material encrypted with the import
+	// public key of the KMS cluster receiving the request.
+	WrappedKey []byte
+
+	// CreatedAt is the point in time when the key version has
+	// been created originally.
+	CreatedAt time.Time
+
+	// CreatedBy is the identity that created the key version
+	// originally.
+	CreatedBy mtls.Identity
+}
+
+// MarshalPB converts the ImportWrapKeyRequest into its protobuf representation.
+func (r *ImportWrapKeyRequest) MarshalPB(v *pb.ImportWrapKeyRequest) error {
+	if len(r.WrappedKey) == 0 {
+		return errors.New("kms: invalid ImportWrapKeyRequest: wrapped key is empty")
+	}
+	if r.Type != 0 {
+		v.Type = r.Type.String()
+	} else {
+		v.Type = ""
+	}
+	if r.CreatedBy != (mtls.Identity{}) {
+		v.CreatedBy = r.CreatedBy.String()
+	} else {
+		v.CreatedBy = ""
+	}
+	if !r.CreatedAt.IsZero() {
+		v.CreatedAt = pb.Time(r.CreatedAt)
+	} else {
+		v.CreatedAt = nil
+	}
+
+	v.Name = r.Name
+	v.Version = uint32(max(r.Version, 0))
+	v.WrappedKey = r.WrappedKey
+	return nil
+}
+
+// UnmarshalPB initializes the ImportWrapKeyRequest from its protobuf representation.
+func (r *ImportWrapKeyRequest) UnmarshalPB(v *pb.ImportWrapKeyRequest) error {
+	var (
+		t  SecretKeyType
+		id mtls.Identity
+	)
+	if v.Type != "" {
+		var err error
+		if t, err = ParseSecretKeyType(v.Type); err != nil {
+			return err
+		}
+	}
+	if v.CreatedBy != "" {
+		var err error
+		if id, err = mtls.ParseIdentity(v.CreatedBy); err != nil {
+			return err
+		}
+	}
+
+	r.Name = v.Name
+	r.Version = int(v.Version)
+	r.Type = t
+	r.WrappedKey = v.WrappedKey
+	r.CreatedAt = time.Time{}
+	if v.CreatedAt != nil {
+		r.CreatedAt = v.CreatedAt.AsTime()
+	}
+	r.CreatedBy = id
+	return nil
+}
+
+// WrappingKeyRequest contains options for fetching the import public
+// key of a KMS cluster.
+type WrappingKeyRequest struct{}
+
+// MarshalPB converts the WrappingKeyRequest into its protobuf representation.
+func (r *WrappingKeyRequest) MarshalPB(*pb.WrappingKeyRequest) error { return nil }
+
+// UnmarshalPB initializes the WrappingKeyRequest from its protobuf representation.
+func (r *WrappingKeyRequest) UnmarshalPB(*pb.WrappingKeyRequest) error { return nil }
+
 // DeleteKeyRequest contains options for deleting secret keys.
 //
 // For removing just a single key version from a key refer to
@@ -538,6 +1010,11 @@ type DeleteKeyRequest struct {
 	// AllVersions indicates whether all key versions should be removed.
 	// If true, Version must be 0.
 	AllVersions bool
+
+	// DryRun indicates whether deleting the key is only validated.
+	// If true, the KMS server checks permissions and preconditions
+	// but does not delete the key.
+	DryRun bool
 }
 
 // MarshalPB converts the DeleteKeyRequest into its protobuf representation.
@@ -564,6 +1041,157 @@ func (r *DeleteKeyRequest) UnmarshalPB(v *pb.DeleteKeyRequest) error {
 	return nil
 }
 
+// SetKeyStateRequest contains options for changing the state
+// of a key version.
+type SetKeyStateRequest struct {
+	// Name is the name of the key.
+	Name string
+
+	// Version is the key version whose state is changed.
+	// If <= 0, refers to latest key version currently present.
+	Version int
+
+	// State is the new state of the key version.
+	State KeyState
+}
+
+// MarshalPB converts the SetKeyStateRequest into its protobuf representation.
+func (r *SetKeyStateRequest) MarshalPB(v *pb.SetKeyStateRequest) error {
+	if r.State == 0 {
+		return errors.New("kms: invalid SetKeyStateRequest: key state is empty")
+	}
+
+	v.Name = r.Name
+	v.Version = uint32(max(r.Version, 0))
+	v.State = r.State.String()
+	return nil
+}
+
+// UnmarshalPB initializes the SetKeyStateRequest from its protobuf representation.
+func (r *SetKeyStateRequest) UnmarshalPB(v *pb.SetKeyStateRequest) error {
+	state, err := ParseKeyState(v.State)
+	if err != nil {
+		return err
+	}
+
+	r.Name = v.Name
+	r.Version = int(v.Version)
+	r.State = state
+	return nil
+}
+
+// NamespaceStatusRequest contains options for fetching
+// metadata about a key namespace.
+type NamespaceStatusRequest struct {
+	// Namespace is the key name prefix of the namespace.
+	// For example, "team/app/". A trailing '/' is added
+	// if missing.
+	Namespace string
+}
+
+// MarshalPB converts the NamespaceStatusRequest into its protobuf representation.
+func (r *NamespaceStatusRequest) MarshalPB(v *pb.NamespaceStatusRequest) error {
+	namespace, err := cleanNamespace(r.Namespace)
+	if err != nil {
+		return err
+	}
+
+	v.Namespace = namespace
+	return nil
+}
+
+// UnmarshalPB initializes the NamespaceStatusRequest from its protobuf representation.
+func (r *NamespaceStatusRequest) UnmarshalPB(v *pb.NamespaceStatusRequest) error {
+	r.Namespace = v.Namespace
+	return nil
+}
+
+// TagNamespaceRequest contains options for changing the
+// tags of a key namespace.
+type TagNamespaceRequest struct {
+	// Namespace is the key name prefix of the namespace.
+	// For example, "team/app/". A trailing '/' is added
+	// if missing.
+	Namespace string
+
+	// Tags are metadata labels added to the namespace.
+	// Existing tags with the same keys are replaced.
+	Tags map[string]string
+
+	// DeleteTags are the keys of tags removed from the
+	// namespace.
+	DeleteTags []string
+}
+
+// MarshalPB converts the TagNamespaceRequest into its protobuf representation.
+func (r *TagNamespaceRequest) MarshalPB(v *pb.TagNamespaceRequest) error {
+	namespace, err := cleanNamespace(r.Namespace)
+	if err != nil {
+		return err
+	}
+
+	v.Namespace = namespace
+	v.Tags = r.Tags
+	v.DeleteTags = r.DeleteTags
+	return nil
+}
+
+// UnmarshalPB initializes the TagNamespaceRequest from its protobuf representation.
+func (r *TagNamespaceRequest) UnmarshalPB(v *pb.TagNamespaceRequest) error {
+	r.Namespace = v.Namespace
+	r.Tags = v.Tags
+	r.DeleteTags = v.DeleteTags
+	return nil
+}
+
+// cleanNamespace returns the namespace with a trailing '/'.
+func cleanNamespace(namespace string) (string, error) {
+	if namespace == "" || namespace == "/" {
+		return "", errors.New("kms: invalid namespace: namespace is empty")
+	}
+	if !strings.HasSuffix(namespace, "/") {
+		namespace += "/"
+	}
+	return namespace, nil
+}
+
+// MoveKeyRequest contains options for moving a key, with
+// all its versions, to another enclave.
+type MoveKeyRequest struct {
+	// Name is the name of the key to move.
+	Name string
+
+	// Destination is the enclave to which the key is moved.
+	Destination string
+
+	// NewName is the name of the key within the destination
+	// enclave. If empty, the key keeps its name.
+	NewName string
+}
+
+// MarshalPB converts the MoveKeyRequest into its protobuf representation.
+func (r *MoveKeyRequest) MarshalPB(v *pb.MoveKeyRequest) error {
+	if r.Name == "" {
+		return errors.New("kms: invalid MoveKeyRequest: key name is empty")
+	}
+	if r.Destination == "" {
+		return errors.New("kms: invalid MoveKeyRequest: destination enclave is empty")
+	}
+
+	v.Name = r.Name
+	v.Destination = r.Destination
+	v.NewName = r.NewName
+	return nil
+}
+
+// UnmarshalPB initializes the MoveKeyRequest from its protobuf representation.
+func (r *MoveKeyRequest) UnmarshalPB(v *pb.MoveKeyRequest) error {
+	r.Name = v.Name
+	r.Destination = v.Destination
+	r.NewName = v.NewName
+	return nil
+}
+
 // KeyStatusRequest contains options for fetching
 // metadata about a key version.
 type KeyStatusRequest struct {
@@ -813,6 +1441,11 @@ type AssignPolicyRequest struct {
 
 	// Identity is the identity to which the policy should apply.
 	Identity mtls.Identity
+
+	// DryRun indicates whether assigning the policy is only
+	// validated. If true, the KMS server checks permissions and
+	// preconditions but does not assign the policy.
+	DryRun bool
 }
 
 // MarshalPB converts the AssignPolicyRequest into its protobuf representation.
@@ -983,3 +1616,122 @@ func (r *DeleteIdentityRequest) UnmarshalPB(v *pb.DeleteIdentityRequest) error {
 	r.Identity = id
 	return nil
 }
+
+// MaxSecretSize is the maximum size of a secret value.
+const MaxSecretSize = 64 * mem.KiB
+
+// CreateSecretRequest contains options for creating secrets.
+type CreateSecretRequest struct {
+	// Name is the name of the secret to create.
+	Name string
+
+	// Value is the secret value. It must not be empty
+	// and must not be larger than MaxSecretSize.
+	Value []byte
+
+	// AddVersion indicates whether a new secret version is created.
+	// By default, trying to create a secret that already exists fails.
+	// If AddVersion is true, a new secret version is created.
+	AddVersion bool
+}
+
+// MarshalPB converts the CreateSecretRequest into its protobuf representation.
+func (r *CreateSecretRequest) MarshalPB(v *pb.CreateSecretRequest) error {
+	if err := validateSecret(r.Name, r.Value); err != nil {
+		return err
+	}
+
+	v.Name = r.Name
+	v.Value = r.Value
+	v.AddVersion = r.AddVersion
+	return nil
+}
+
+// UnmarshalPB initializes the CreateSecretRequest from its protobuf representation.
+func (r *CreateSecretRequest) UnmarshalPB(v *pb.CreateSecretRequest) error {
+	if err := validateSecret(v.Name, v.Value); err != nil {
+		return err
+	}
+
+	r.Name = v.Name
+	r.Value = v.Value
+	r.AddVersion = v.AddVersion
+	return nil
+}
+
+// validateSecret returns an error if the secret name
+// is empty or the value is empty or too large.
+func validateSecret(name string, value []byte) error {
+	if name == "" {
+		return errors.New("kms: invalid CreateSecretRequest: secret name is empty")
+	}
+	if len(value) == 0 {
+		return errors.New("kms: invalid CreateSecretRequest: secret value is empty")
+	}
+	if mem.Size(len(value)) > MaxSecretSize {
+		return errors.New("kms: invalid CreateSecretRequest: secret value is too large")
+	}
+	return nil
+}
+
+// SecretRequest contains options for fetching a secret version.
+type SecretRequest struct {
+	// Name is the name of the secret.
+	Name string
+
+	// Version is the secret version. If <= 0, refers to
+	// latest secret version currently present.
+	Version int
+}
+
+// MarshalPB converts the SecretRequest into its protobuf representation.
+func (r *SecretRequest) MarshalPB(v *pb.SecretRequest) error {
+	v.Name = r.Name
+	v.Version = uint32(max(r.Version, 0))
+	return nil
+}
+
+// UnmarshalPB initializes the SecretRequest from its protobuf representation.
+func (r *SecretRequest) UnmarshalPB(v *pb.SecretRequest) error {
+	r.Name = v.Name
+	r.Version = int(v.Version)
+	return nil
+}
+
+// DeleteSecretRequest contains options for deleting secrets.
+type DeleteSecretRequest struct {
+	// Name is the name of the secret to delete.
+	Name string
+
+	// Version is the secret version to remove. If <= 0, refers
+	// to latest secret version currently present.
+	Version int
+
+	// AllVersions indicates whether all secret versions should be
+	// removed. If true, Version must be 0.
+	AllVersions bool
+}
+
+// MarshalPB converts the DeleteSecretRequest into its protobuf representation.
+func (r *DeleteSecretRequest) MarshalPB(v *pb.DeleteSecretRequest) error {
+	if r.AllVersions && r.Version > 0 {
+		return errors.New("kms: invalid DeleteSecretRequest: all versions and non-zero version are incompatible")
+	}
+
+	v.Name = r.Name
+	v.Version = uint32(max(r.Version, 0))
+	v.AllVersions = r.AllVersions
+	return nil
+}
+
+// UnmarshalPB initializes the DeleteSecretRequest from its protobuf representation.
+func (r *DeleteSecretRequest) UnmarshalPB(v *pb.DeleteSecretRequest) error {
+	if v.AllVersions && v.Version > 0 {
+		return errors.New("kms: invalid DeleteSecretRequest: all versions and non-zero version are incompatible")
+	}
+
+	r.Name = v.Name
+	r.Version = int(v.Version)
+	r.AllVersions = v.AllVersions
+	return nil
+}
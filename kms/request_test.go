@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	pb "github.com/openstor/kms-go/kms/protobuf"
+)
+
+func TestExportKeyRequest_MarshalPB(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range exportKeyRequestTests {
+		var v pb.ExportKeyRequest
+		err := test.Request.MarshalPB(&v)
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: marshaling request should have failed", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to marshal request: %v", i, err)
+		}
+		if test.ShouldFail {
+			continue
+		}
+
+		var r ExportKeyRequest
+		if err = r.UnmarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to unmarshal request: %v", i, err)
+		}
+		if r.Name != test.Request.Name || r.Version != test.Version || !bytes.Equal(r.WrappingKey, test.Request.WrappingKey) {
+			t.Fatalf("Test %d: request mismatch: got '%+v' - want '%+v'", i, r, test.Request)
+		}
+	}
+}
+
+var exportKeyRequestTests = []struct {
+	Request    ExportKeyRequest
+	Version    int
+	ShouldFail bool
+}{
+	{Request: ExportKeyRequest{Name: "my-key", WrappingKey: []byte("public-key")}},                         // 0
+	{Request: ExportKeyRequest{Name: "my-key", Version: 3, WrappingKey: []byte("public-key")}, Version: 3}, // 1
+	{Request: ExportKeyRequest{Name: "my-key", Version: -1, WrappingKey: []byte("public-key")}},            // 2 - Negative versions refer to the latest
+	{Request: ExportKeyRequest{Name: "my-key"}, ShouldFail: true},                                          // 3
+}
+
+func TestImportWrapKeyRequest_MarshalPB(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range importWrapKeyRequestTests {
+		var v pb.ImportWrapKeyRequest
+		err := test.Request.MarshalPB(&v)
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: marshaling request should have failed", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to marshal request: %v", i, err)
+		}
+		if test.ShouldFail {
+			continue
+		}
+
+		var r ImportWrapKeyRequest
+		if err = r.UnmarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to unmarshal request: %v", i, err)
+		}
+		if r.Name != test.Request.Name || r.Version != test.Request.Version || r.Type != test.Request.Type || !bytes.Equal(r.WrappedKey, test.Request.WrappedKey) {
+			t.Fatalf("Test %d: request mismatch: got '%+v' - want '%+v'", i, r, test.Request)
+		}
+		if !r.CreatedAt.Equal(test.Request.CreatedAt) || r.CreatedBy != test.Request.CreatedBy {
+			t.Fatalf("Test %d: request mismatch: got '%+v' - want '%+v'", i, r, test.Request)
+		}
+	}
+}
+
+var importWrapKeyRequestTests = []struct {
+	Request    ImportWrapKeyRequest
+	ShouldFail bool
+}{
+	{Request: ImportWrapKeyRequest{Name: "my-key", WrappedKey: []byte("wrapped-key")}}, // 0 - Zero type, time and identity are sent as empty
+	{ // 1
+		Request: ImportWrapKeyRequest{
+			Name:       "my-key",
+			Version:    2,
+			Type:       AES256,
+			WrappedKey: []byte("wrapped-key"),
+			CreatedAt:  time.Unix(1700000000, 0).UTC(),
+			CreatedBy:  mustParseIdentity("h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w"),
+		},
+	},
+	{Request: ImportWrapKeyRequest{Name: "my-key", Type: AES256}, ShouldFail: true}, // 2
+}
+
+func TestExportEnclaveRequest_MarshalPB(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range exportEnclaveRequestTests {
+		var v pb.ExportEnclaveRequest
+		err := test.Request.MarshalPB(&v)
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: marshaling request should have failed", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to marshal request: %v", i, err)
+		}
+		if test.ShouldFail {
+			continue
+		}
+
+		var r ExportEnclaveRequest
+		if err = r.UnmarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to unmarshal request: %v", i, err)
+		}
+		if r.Name != test.Request.Name || !bytes.Equal(r.ExportKey, test.Request.ExportKey) {
+			t.Fatalf("Test %d: request mismatch: got '%+v' - want '%+v'", i, r, test.Request)
+		}
+	}
+}
+
+var exportEnclaveRequestTests = []struct {
+	Request    ExportEnclaveRequest
+	ShouldFail bool
+}{
+	{Request: ExportEnclaveRequest{Name: "my-enclave"}},                                                // 0
+	{Request: ExportEnclaveRequest{Name: "my-enclave", ExportKey: make([]byte, 32)}},                   // 1
+	{Request: ExportEnclaveRequest{Name: "my-enclave", ExportKey: make([]byte, 16)}, ShouldFail: true}, // 2
+}
+
+func TestImportEnclaveRequest_MarshalPB(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range importEnclaveRequestTests {
+		var v pb.ImportEnclaveRequest
+		err := test.Request.MarshalPB(&v)
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: marshaling request should have failed", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to marshal request: %v", i, err)
+		}
+		if test.ShouldFail {
+			continue
+		}
+
+		var r ImportEnclaveRequest
+		if err = r.UnmarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to unmarshal request: %v", i, err)
+		}
+		if r.Name != test.Request.Name || !bytes.Equal(r.Archive, test.Request.Archive) || !bytes.Equal(r.ExportKey, test.Request.ExportKey) {
+			t.Fatalf("Test %d: request mismatch: got '%+v' - want '%+v'", i, r, test.Request)
+		}
+	}
+}
+
+var importEnclaveRequestTests = []struct {
+	Request    ImportEnclaveRequest
+	ShouldFail bool
+}{
+	{Request: ImportEnclaveRequest{Archive: []byte("archive")}},                                                   // 0
+	{Request: ImportEnclaveRequest{Name: "new-enclave", Archive: []byte("archive"), ExportKey: make([]byte, 32)}}, // 1
+	{Request: ImportEnclaveRequest{Name: "new-enclave"}, ShouldFail: true},                                        // 2
+	{Request: ImportEnclaveRequest{Archive: []byte("archive"), ExportKey: make([]byte, 31)}, ShouldFail: true},    // 3
+}
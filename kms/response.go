@@ -6,9 +6,11 @@ package kms
 
 import (
 	"compress/gzip"
+	"encoding/binary"
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"aead.dev/mtls"
@@ -78,6 +80,62 @@ func decodeResponseMessage(r *http.Response, c cmds.Command, v proto.Message) er
 	return nil
 }
 
+// decodeDryRunResponse decodes one DryRunResponse per command
+// in the request body from the response r. It walks the request
+// body and the response in lockstep and returns an error if any
+// response does not belong to the request command at the same
+// position.
+func decodeDryRunResponse(r *http.Response, body []byte) ([]*DryRunResponse, error) {
+	if r.ContentLength < 0 {
+		return nil, &HostError{
+			Host: r.Request.Host,
+			Err:  Error{http.StatusLengthRequired, "request content length is negative"},
+		}
+	}
+
+	buf := make([]byte, r.ContentLength)
+	if _, err := io.ReadFull(r.Body, buf); err != nil {
+		return nil, hostError(r.Request.Host, err)
+	}
+
+	_, n := cmds.Inspect(body)
+	if n == 0 && len(body) > 0 {
+		return nil, errors.New("kms: invalid dry-run request body")
+	}
+	responses := make([]*DryRunResponse, 0, n)
+	for len(body) > 0 {
+		want := cmds.Command(binary.BigEndian.Uint16(body))
+		body = body[6+binary.BigEndian.Uint32(body[2:]):] // Inspect has checked the length
+
+		if len(buf) < 2 {
+			break
+		}
+		if cmd := cmds.Command(binary.BigEndian.Uint16(buf)); cmd != want {
+			return nil, &HostError{
+				Host: r.Request.Host,
+				Err:  errors.New("kms: dry-run response for '" + cmd.String() + "' does not match request command '" + want.String() + "'"),
+			}
+		}
+
+		var (
+			resp DryRunResponse
+			err  error
+		)
+		if buf, err = cmds.Decode(buf, want, &resp); err != nil {
+			return nil, hostError(r.Request.Host, err)
+		}
+		resp.Err = hostError(r.Request.Host, resp.Err)
+		responses = append(responses, &resp)
+	}
+	if len(responses) != n || len(buf) > 0 {
+		return nil, &HostError{
+			Host: r.Request.Host,
+			Err:  errors.New("kms: dry-run response does not match request commands"),
+		}
+	}
+	return responses, nil
+}
+
 // readResponse reads the response body into v using the
 // response content encoding.
 //
@@ -125,6 +183,12 @@ type Page[T any] struct {
 	// page from where to resume the listing. Empty at
 	// the end of the listing.
 	ContinueAt string
+
+	// CommonPrefixes contains the common prefixes of names
+	// that contain the ListRequest.Delimiter after the
+	// ListRequest.Prefix. It is empty when listing without
+	// a delimiter.
+	CommonPrefixes []string
 }
 
 // VersionResponse contains version information about a KMS server.
@@ -199,10 +263,18 @@ type ServerStatusResponse struct {
 	// of node IDs to KMS server addresses of the form 'host' or 'host:port'.
 	Nodes map[int]string
 
+	// NodeRoles is a map of node IDs to the role of the corresponding KMS
+	// server within the cluster. It contains the same node IDs as Nodes.
+	NodeRoles map[int]NodeRole
+
 	// ID is the node ID of this KMS server. It only changes if the node
 	// joins a cluster.
 	ID int
 
+	// NodeRole is the role of this KMS server within the cluster. Either,
+	// NodeVoter or NodeLearner.
+	NodeRole NodeRole
+
 	// LeaderID is the ID of the current cluster leader or negative if
 	// the cluster has no leader.
 	LeaderID int
@@ -286,7 +358,18 @@ func (s *ServerStatusResponse) MarshalPB(v *pb.ServerStatusResponse) error {
 	for id, node := range s.Nodes {
 		v.Nodes[uint32(id)] = node
 	}
+	v.NodeRoles = make(map[uint32]string, len(s.NodeRoles))
+	for id, role := range s.NodeRoles {
+		if role != 0 {
+			v.NodeRoles[uint32(id)] = role.String()
+		}
+	}
 	v.ID = uint32(s.ID)
+	if s.NodeRole != 0 {
+		v.NodeRole = s.NodeRole.String()
+	} else {
+		v.NodeRole = ""
+	}
 	v.LeaderID = int64(s.LeaderID)
 	v.LastHeartbeat = pb.Duration(s.LastHeartbeat)
 	v.HeartbeatInterval = pb.Duration(s.HeartbeatInterval)
@@ -304,6 +387,11 @@ func (s *ServerStatusResponse) MarshalPB(v *pb.ServerStatusResponse) error {
 
 // UnmarshalPB initializes the ServerStatusResponse from its protobuf representation.
 func (s *ServerStatusResponse) UnmarshalPB(v *pb.ServerStatusResponse) error {
+	nodeRole, err := parseNodeRole(v.NodeRole)
+	if err != nil {
+		return err
+	}
+
 	s.Version = v.Version
 	s.APIVersion = v.APIVersion
 	s.Host = v.Host
@@ -314,7 +402,16 @@ func (s *ServerStatusResponse) UnmarshalPB(v *pb.ServerStatusResponse) error {
 	for id, node := range v.Nodes {
 		s.Nodes[int(id)] = node
 	}
+	s.NodeRoles = make(map[int]NodeRole, len(v.Nodes))
+	for id := range v.Nodes {
+		role, err := parseNodeRole(v.NodeRoles[id])
+		if err != nil {
+			return err
+		}
+		s.NodeRoles[int(id)] = role
+	}
 	s.ID = int(v.ID)
+	s.NodeRole = nodeRole
 	s.LeaderID = int(v.LeaderID)
 	s.LastHeartbeat = v.LastHeartbeat.AsDuration()
 	s.HeartbeatInterval = v.HeartbeatInterval.AsDuration()
@@ -402,6 +499,11 @@ type ClusterStatusResponse struct {
 	// NodesDown is a map of node IDs to node addresses containing
 	// all nodes that were not reachable or failed to respond in time.
 	NodesDown map[int]string
+
+	// NodeRoles is a map of node IDs to the role of the corresponding
+	// KMS server within the cluster. It contains all nodes that are
+	// either up or down.
+	NodeRoles map[int]NodeRole
 }
 
 // MarshalPB converts the ClusterStatusResponse into its protobuf representation.
@@ -419,6 +521,13 @@ func (s *ClusterStatusResponse) MarshalPB(v *pb.ClusterStatusResponse) error {
 	for id, addr := range s.NodesDown {
 		v.NodesDown[uint32(id)] = addr
 	}
+
+	v.NodeRoles = make(map[uint32]string, len(s.NodeRoles))
+	for id, role := range s.NodeRoles {
+		if role != 0 {
+			v.NodeRoles[uint32(id)] = role.String()
+		}
+	}
 	return nil
 }
 
@@ -437,12 +546,38 @@ func (s *ClusterStatusResponse) UnmarshalPB(v *pb.ClusterStatusResponse) error {
 	for id, addr := range v.NodesDown {
 		s.NodesDown[int(id)] = addr
 	}
+
+	s.NodeRoles = make(map[int]NodeRole, len(v.NodesUp)+len(v.NodesDown))
+	for id := range v.NodesUp {
+		role, err := parseNodeRole(v.NodeRoles[id])
+		if err != nil {
+			return err
+		}
+		s.NodeRoles[int(id)] = role
+	}
+	for id := range v.NodesDown {
+		role, err := parseNodeRole(v.NodeRoles[id])
+		if err != nil {
+			return err
+		}
+		s.NodeRoles[int(id)] = role
+	}
 	return nil
 }
 
 // ReadDBResponse contains the database content received from a KMS server.
 type ReadDBResponse struct {
 	Body io.ReadCloser // The database content
+
+	// Since is the commit index after which an incremental snapshot
+	// contains state changes. It is 0 for full snapshots.
+	Since uint64
+
+	// Commit is the commit index of the most recent state change
+	// contained in the snapshot. It should be used as Since for the
+	// next incremental snapshot. It is 0 if the KMS server does not
+	// report commit indices.
+	Commit uint64
 }
 
 // Read reads data from the response body into b.
@@ -498,11 +633,15 @@ func (r *ProfileResponse) Close() error {
 	return r.Body.Close()
 }
 
-// LogResponse is a continuous stream of server log records.
+// LogResponse is a stream of server log records. It is
+// continuous unless the LogRequest specifies an end, like
+// a point in time or a limit.
 type LogResponse struct {
-	r   io.ReadCloser
-	buf []byte
-	err error
+	r      io.ReadCloser
+	buf    []byte
+	host   string
+	cursor string
+	err    error
 }
 
 // Next returns the next LogRecord, if any, and a boolean
@@ -521,9 +660,17 @@ func (r *LogResponse) Next() (LogRecord, bool) {
 		r.Close()
 		return LogRecord{}, false
 	}
+	if rec.Cursor != "" {
+		r.cursor = rec.Cursor
+	}
 	return rec, true
 }
 
+// ContinueAt returns the cursor of the last LogRecord returned
+// by Next, if any. It can be used as LogRequest.ContinueAt to
+// fetch the next page of a historical log query.
+func (r *LogResponse) ContinueAt() string { return r.cursor }
+
 // Close closes the underlying stream and returns the
 // first error encountered while reading. If no error
 // has been encountered, it returns the first error
@@ -571,6 +718,31 @@ func (r *EnclaveStatusResponse) UnmarshalPB(v *pb.EnclaveStatusResponse) error {
 	return nil
 }
 
+// ExportEnclaveResponse contains an exported enclave archive.
+type ExportEnclaveResponse struct {
+	// Name is the name of the exported enclave.
+	Name string
+
+	// Archive is the encrypted and integrity-protected enclave
+	// archive containing the enclave's keys, policies and
+	// identities. Refer to ImportEnclave.
+	Archive []byte
+}
+
+// MarshalPB converts the ExportEnclaveResponse into its protobuf representation.
+func (r *ExportEnclaveResponse) MarshalPB(v *pb.ExportEnclaveResponse) error {
+	v.Name = r.Name
+	v.Archive = r.Archive
+	return nil
+}
+
+// UnmarshalPB initializes the ExportEnclaveResponse from its protobuf representation.
+func (r *ExportEnclaveResponse) UnmarshalPB(v *pb.ExportEnclaveResponse) error {
+	r.Name = v.Name
+	r.Archive = v.Archive
+	return nil
+}
+
 // KeyStatusResponse contains information about a secret key version.
 type KeyStatusResponse struct {
 	// Name is the name of the secret key ring.
@@ -587,6 +759,14 @@ type KeyStatusResponse struct {
 
 	// CreatedBy is the identity that created this key version.
 	CreatedBy mtls.Identity
+
+	// State is the state of this key version. For example, KeyEnabled.
+	State KeyState
+
+	// KCV is the key check value of this key version. Identical
+	// keys have the same KCV. It is empty if the server does not
+	// compute key check values. Refer to KCV and VerifyKCV.
+	KCV []byte
 }
 
 // MarshalPB converts the KeyStatusResponse into its protobuf representation.
@@ -596,6 +776,12 @@ func (r *KeyStatusResponse) MarshalPB(v *pb.KeyStatusResponse) error {
 	v.Type = r.Type.String()
 	v.CreatedAt = pb.Time(r.CreatedAt)
 	v.CreatedBy = r.CreatedBy.String()
+	if r.State != 0 {
+		v.State = r.State.String()
+	} else {
+		v.State = ""
+	}
+	v.KCV = r.KCV
 	return nil
 }
 
@@ -610,11 +796,104 @@ func (r *KeyStatusResponse) UnmarshalPB(v *pb.KeyStatusResponse) error {
 		return err
 	}
 
+	if len(v.KCV) != 0 && len(v.KCV) != KCVSize {
+		return errors.New("kms: invalid key check value length")
+	}
+
+	// Servers that don't support key version states
+	// don't send any state. All key versions are
+	// enabled implicitly.
+	state := KeyEnabled
+	if v.State != "" {
+		if state, err = ParseKeyState(v.State); err != nil {
+			return err
+		}
+	}
+
 	r.Name = v.Name
 	r.Type = t
 	r.Version = int(v.Version)
 	r.CreatedAt = v.CreatedAt.AsTime()
 	r.CreatedBy = id
+	r.State = state
+	r.KCV = v.KCV
+	return nil
+}
+
+// ExportKeyResponse contains an exported key version. The key material
+// is wrapped for the KMS cluster that owns the wrapping key used for
+// the export.
+type ExportKeyResponse struct {
+	// Name is the name of the exported key.
+	Name string
+
+	// Version is the version of the exported key within the key ring.
+	Version int
+
+	// Type is the type of the exported key. For example, AES256.
+	Type SecretKeyType
+
+	// CreatedAt is the point in time when the key version has been created.
+	CreatedAt time.Time
+
+	// CreatedBy is the identity that created the key version.
+	CreatedBy mtls.Identity
+
+	// WrappedKey is the key material encrypted with the wrapping key.
+	// It can only be imported by the KMS cluster in possession of the
+	// corresponding private key.
+	WrappedKey []byte
+}
+
+// MarshalPB converts the ExportKeyResponse into its protobuf representation.
+func (r *ExportKeyResponse) MarshalPB(v *pb.ExportKeyResponse) error {
+	v.Name = r.Name
+	v.Version = uint32(r.Version)
+	v.Type = r.Type.String()
+	v.CreatedAt = pb.Time(r.CreatedAt)
+	v.CreatedBy = r.CreatedBy.String()
+	v.WrappedKey = r.WrappedKey
+	return nil
+}
+
+// UnmarshalPB initializes the ExportKeyResponse from its protobuf representation.
+func (r *ExportKeyResponse) UnmarshalPB(v *pb.ExportKeyResponse) error {
+	var id mtls.Identity
+	t, err := ParseSecretKeyType(v.Type)
+	if err != nil {
+		return err
+	}
+	if v.CreatedBy != "" {
+		if id, err = mtls.ParseIdentity(v.CreatedBy); err != nil {
+			return err
+		}
+	}
+
+	r.Name = v.Name
+	r.Version = int(v.Version)
+	r.Type = t
+	r.CreatedAt = v.CreatedAt.AsTime()
+	r.CreatedBy = id
+	r.WrappedKey = v.WrappedKey
+	return nil
+}
+
+// WrappingKeyResponse contains the import public key of a KMS cluster.
+type WrappingKeyResponse struct {
+	// PublicKey is the DER-encoded PKIX public key used to wrap
+	// key material exported to the KMS cluster.
+	PublicKey []byte
+}
+
+// MarshalPB converts the WrappingKeyResponse into its protobuf representation.
+func (r *WrappingKeyResponse) MarshalPB(v *pb.WrappingKeyResponse) error {
+	v.PublicKey = r.PublicKey
+	return nil
+}
+
+// UnmarshalPB initializes the WrappingKeyResponse from its protobuf representation.
+func (r *WrappingKeyResponse) UnmarshalPB(v *pb.WrappingKeyResponse) error {
+	r.PublicKey = v.PublicKey
 	return nil
 }
 
@@ -695,6 +974,37 @@ func (r *GenerateKeyResponse) UnmarshalPB(v *pb.GenerateKeyResponse) error {
 	return nil
 }
 
+// NamespaceStatusResponse contains metadata about a key namespace.
+type NamespaceStatusResponse struct {
+	// Namespace is the key name prefix of the namespace,
+	// ending with a '/'. For example, "team/app/".
+	Namespace string
+
+	// Keys is the number of keys within the namespace,
+	// including keys within nested namespaces.
+	Keys uint64
+
+	// Tags are optional metadata labels attached to
+	// the namespace.
+	Tags map[string]string
+}
+
+// MarshalPB converts the NamespaceStatusResponse into its protobuf representation.
+func (r *NamespaceStatusResponse) MarshalPB(v *pb.NamespaceStatusResponse) error {
+	v.Namespace = r.Namespace
+	v.Keys = r.Keys
+	v.Tags = r.Tags
+	return nil
+}
+
+// UnmarshalPB initializes the NamespaceStatusResponse from its protobuf representation.
+func (r *NamespaceStatusResponse) UnmarshalPB(v *pb.NamespaceStatusResponse) error {
+	r.Namespace = v.Namespace
+	r.Keys = v.Keys
+	r.Tags = v.Tags
+	return nil
+}
+
 // MACResponse contains a message authentication code for a given message.
 type MACResponse struct {
 	// Version identifies the particular key within a key ring used to generate
@@ -932,3 +1242,124 @@ func (r *IdentityResponse) UnmarshalPB(v *pb.IdentityResponse) error {
 	r.Tags = v.Tags
 	return nil
 }
+
+// SecretStatusResponse contains information about a secret version.
+type SecretStatusResponse struct {
+	// Name is the name of the secret.
+	Name string
+
+	// Version is the version of the secret.
+	Version int
+
+	// CreatedAt is the point in time when this secret version has been created.
+	CreatedAt time.Time
+
+	// CreatedBy is the identity that created this secret version.
+	CreatedBy mtls.Identity
+}
+
+// MarshalPB converts the SecretStatusResponse into its protobuf representation.
+func (r *SecretStatusResponse) MarshalPB(v *pb.SecretStatusResponse) error {
+	v.Name = r.Name
+	v.Version = uint32(r.Version)
+	v.CreatedAt = pb.Time(r.CreatedAt)
+	v.CreatedBy = r.CreatedBy.String()
+	return nil
+}
+
+// UnmarshalPB initializes the SecretStatusResponse from its protobuf representation.
+func (r *SecretStatusResponse) UnmarshalPB(v *pb.SecretStatusResponse) error {
+	id, err := mtls.ParseIdentity(v.CreatedBy)
+	if err != nil {
+		return err
+	}
+
+	r.Name = v.Name
+	r.Version = int(v.Version)
+	r.CreatedAt = v.CreatedAt.AsTime()
+	r.CreatedBy = id
+	return nil
+}
+
+// SecretResponse contains a secret version and its value.
+type SecretResponse struct {
+	// Name is the name of the secret.
+	Name string
+
+	// Version is the version of the secret.
+	Version int
+
+	// Value is the secret value.
+	Value []byte
+
+	// CreatedAt is the point in time when this secret version has been created.
+	CreatedAt time.Time
+
+	// CreatedBy is the identity that created this secret version.
+	CreatedBy mtls.Identity
+}
+
+// MarshalPB converts the SecretResponse into its protobuf representation.
+func (r *SecretResponse) MarshalPB(v *pb.SecretResponse) error {
+	v.Name = r.Name
+	v.Version = uint32(r.Version)
+	v.Value = r.Value
+	v.CreatedAt = pb.Time(r.CreatedAt)
+	v.CreatedBy = r.CreatedBy.String()
+	return nil
+}
+
+// UnmarshalPB initializes the SecretResponse from its protobuf representation.
+func (r *SecretResponse) UnmarshalPB(v *pb.SecretResponse) error {
+	id, err := mtls.ParseIdentity(v.CreatedBy)
+	if err != nil {
+		return err
+	}
+
+	r.Name = v.Name
+	r.Version = int(v.Version)
+	r.Value = v.Value
+	r.CreatedAt = v.CreatedAt.AsTime()
+	r.CreatedBy = id
+	return nil
+}
+
+// DryRunResponse is the result of validating a single command
+// without executing it. Refer to Client.DryRun.
+type DryRunResponse struct {
+	// Err is the error the command would fail with. It is nil
+	// if the command would succeed. Otherwise, it wraps an
+	// Error, like ErrKeyNotFound or ErrPermission.
+	Err error
+}
+
+// MarshalPB converts the DryRunResponse into its protobuf representation.
+func (r *DryRunResponse) MarshalPB(v *pb.DryRunResponse) error {
+	if r.Err == nil {
+		v.Code = http.StatusOK
+		v.Message = ""
+		return nil
+	}
+
+	var err Error
+	if !errors.As(r.Err, &err) {
+		err = Error{http.StatusBadRequest, r.Err.Error()}
+	}
+	v.Code = uint32(err.Code)
+	v.Message = err.Err
+	return nil
+}
+
+// UnmarshalPB initializes the DryRunResponse from its protobuf representation.
+func (r *DryRunResponse) UnmarshalPB(v *pb.DryRunResponse) error {
+	if v.Code == http.StatusOK {
+		r.Err = nil
+		return nil
+	}
+	if v.Code < 400 || v.Code > 599 {
+		return errors.New("kms: invalid dry-run status code " + strconv.Itoa(int(v.Code)))
+	}
+
+	r.Err = Error{int(v.Code), v.Message}
+	return nil
+}
@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms
+
+import (
+	"bytes"
+	"maps"
+	"testing"
+	"time"
+
+	"aead.dev/mtls"
+	pb "github.com/openstor/kms-go/kms/protobuf"
+)
+
+func TestKeyStatusResponse_MarshalPB(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range keyStatusResponseTests {
+		var v pb.KeyStatusResponse
+		if err := test.Response.MarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to marshal response: %v", i, err)
+		}
+
+		var r KeyStatusResponse
+		if err := r.UnmarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to unmarshal response: %v", i, err)
+		}
+		if r.Name != test.Response.Name || r.Version != test.Response.Version || r.Type != test.Response.Type {
+			t.Fatalf("Test %d: response mismatch: got '%+v' - want '%+v'", i, r, test.Response)
+		}
+		if !r.CreatedAt.Equal(test.Response.CreatedAt) || r.CreatedBy != test.Response.CreatedBy {
+			t.Fatalf("Test %d: response mismatch: got '%+v' - want '%+v'", i, r, test.Response)
+		}
+		if r.State != test.State {
+			t.Fatalf("Test %d: key state mismatch: got '%v' - want '%v'", i, r.State, test.State)
+		}
+	}
+}
+
+var keyStatusResponseTests = []struct {
+	Response KeyStatusResponse
+	State    KeyState
+}{
+	{ // 0
+		Response: KeyStatusResponse{Name: "my-key", Version: 1, Type: AES256},
+		State:    KeyEnabled, // A zero state is sent as no state
+	},
+	{ // 1
+		Response: KeyStatusResponse{Name: "my-key", Version: 2, Type: AES256, State: KeyDecryptOnly, CreatedAt: time.Unix(1700000000, 0).UTC()},
+		State:    KeyDecryptOnly,
+	},
+	{ // 2
+		Response: KeyStatusResponse{Name: "my-key", Version: 3, Type: ChaCha20, State: KeyDisabled},
+		State:    KeyDisabled,
+	},
+}
+
+func TestClusterStatusResponse_MarshalPB(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range clusterStatusResponseTests {
+		var v pb.ClusterStatusResponse
+		if err := test.Response.MarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to marshal response: %v", i, err)
+		}
+
+		var r ClusterStatusResponse
+		if err := r.UnmarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to unmarshal response: %v", i, err)
+		}
+		if !maps.Equal(r.NodeRoles, test.NodeRoles) {
+			t.Fatalf("Test %d: node roles mismatch: got '%v' - want '%v'", i, r.NodeRoles, test.NodeRoles)
+		}
+		for id, node := range r.NodesUp {
+			if node.NodeRole != test.NodeRoles[id] {
+				t.Fatalf("Test %d: node role of node %d mismatch: got '%v' - want '%v'", i, id, node.NodeRole, test.NodeRoles[id])
+			}
+			if !maps.Equal(node.NodeRoles, test.NodeRoles) {
+				t.Fatalf("Test %d: node roles of node %d mismatch: got '%v' - want '%v'", i, id, node.NodeRoles, test.NodeRoles)
+			}
+		}
+	}
+}
+
+var clusterStatusResponseTests = []struct {
+	Response  ClusterStatusResponse
+	NodeRoles map[int]NodeRole
+}{
+	{ // 0 - Servers without learner support don't report any roles
+		Response: ClusterStatusResponse{
+			NodesUp: map[int]*ServerStatusResponse{
+				0: {Host: "10.0.0.1:7373", ID: 0, Nodes: map[int]string{0: "10.0.0.1:7373", 1: "10.0.0.2:7373"}},
+			},
+			NodesDown: map[int]string{1: "10.0.0.2:7373"},
+		},
+		NodeRoles: map[int]NodeRole{0: NodeVoter, 1: NodeVoter},
+	},
+	{ // 1
+		Response: ClusterStatusResponse{
+			NodesUp: map[int]*ServerStatusResponse{
+				0: {
+					Host:      "10.0.0.1:7373",
+					ID:        0,
+					Nodes:     map[int]string{0: "10.0.0.1:7373", 1: "10.0.0.2:7373"},
+					NodeRoles: map[int]NodeRole{0: NodeVoter, 1: NodeLearner},
+					NodeRole:  NodeVoter,
+				},
+				1: {
+					Host:      "10.0.0.2:7373",
+					ID:        1,
+					Nodes:     map[int]string{0: "10.0.0.1:7373", 1: "10.0.0.2:7373"},
+					NodeRoles: map[int]NodeRole{0: NodeVoter, 1: NodeLearner},
+					NodeRole:  NodeLearner,
+				},
+			},
+			NodeRoles: map[int]NodeRole{0: NodeVoter, 1: NodeLearner},
+		},
+		NodeRoles: map[int]NodeRole{0: NodeVoter, 1: NodeLearner},
+	},
+	{ // 2 - Zero roles are sent as no role
+		Response: ClusterStatusResponse{
+			NodesUp: map[int]*ServerStatusResponse{
+				0: {
+					Host:      "10.0.0.1:7373",
+					ID:        0,
+					Nodes:     map[int]string{0: "10.0.0.1:7373"},
+					NodeRoles: map[int]NodeRole{0: 0},
+				},
+			},
+			NodeRoles: map[int]NodeRole{0: 0},
+		},
+		NodeRoles: map[int]NodeRole{0: NodeVoter},
+	},
+}
+
+func TestExportKeyResponse_MarshalPB(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range exportKeyResponseTests {
+		var v pb.ExportKeyResponse
+		if err := test.MarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to marshal response: %v", i, err)
+		}
+
+		var r ExportKeyResponse
+		if err := r.UnmarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to unmarshal response: %v", i, err)
+		}
+		if r.Name != test.Name || r.Version != test.Version || r.Type != test.Type || !bytes.Equal(r.WrappedKey, test.WrappedKey) {
+			t.Fatalf("Test %d: response mismatch: got '%+v' - want '%+v'", i, r, test)
+		}
+		if !r.CreatedAt.Equal(test.CreatedAt) || r.CreatedBy != test.CreatedBy {
+			t.Fatalf("Test %d: response mismatch: got '%+v' - want '%+v'", i, r, test)
+		}
+	}
+
+	r := WrappingKeyResponse{PublicKey: []byte("public-key")}
+	var v pb.WrappingKeyResponse
+	if err := r.MarshalPB(&v); err != nil {
+		t.Fatalf("Failed to marshal wrapping key response: %v", err)
+	}
+	var w WrappingKeyResponse
+	if err := w.UnmarshalPB(&v); err != nil {
+		t.Fatalf("Failed to unmarshal wrapping key response: %v", err)
+	}
+	if !bytes.Equal(w.PublicKey, r.PublicKey) {
+		t.Fatalf("Public key mismatch: got '%x' - want '%x'", w.PublicKey, r.PublicKey)
+	}
+}
+
+var exportKeyResponseTests = []ExportKeyResponse{
+	{Name: "my-key", Version: 1, Type: AES256, CreatedAt: time.Unix(1700000000, 0).UTC(), WrappedKey: []byte("wrapped-key")}, // 0
+	{ // 1
+		Name:       "my-key",
+		Version:    7,
+		Type:       ChaCha20,
+		CreatedAt:  time.Unix(1700000000, 500).UTC(),
+		CreatedBy:  mustParseIdentity("h1:Ws3-yQPEhXo_zjo9KV5-g_kmV8-79nBp0R6-CmbGz3w"),
+		WrappedKey: []byte("wrapped-key"),
+	},
+}
+
+func TestExportEnclaveResponse_MarshalPB(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range []ExportEnclaveResponse{
+		{Name: "my-enclave"},                             // 0
+		{Name: "my-enclave", Archive: []byte("archive")}, // 1
+	} {
+		var v pb.ExportEnclaveResponse
+		if err := test.MarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to marshal response: %v", i, err)
+		}
+
+		var r ExportEnclaveResponse
+		if err := r.UnmarshalPB(&v); err != nil {
+			t.Fatalf("Test %d: failed to unmarshal response: %v", i, err)
+		}
+		if r.Name != test.Name || !bytes.Equal(r.Archive, test.Archive) {
+			t.Fatalf("Test %d: response mismatch: got '%+v' - want '%+v'", i, r, test)
+		}
+	}
+}
+
+func mustParseIdentity(s string) mtls.Identity {
+	id, err := mtls.ParseIdentity(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
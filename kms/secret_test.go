@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package kms_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"aead.dev/mtls"
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/cmds"
+	"github.com/openstor/kms-go/kms/kmstest"
+)
+
+func TestClient_Secrets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := kmstest.NewServer(nil)
+	defer server.Close()
+
+	client := kmstest.NewClient(t, server)
+	if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "my-enclave"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+
+	for i, test := range createSecretTests {
+		err := client.CreateSecret(ctx, "my-enclave", &test.Request)
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: creating secret should have failed", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to create secret: %v", i, err)
+		}
+	}
+	if err := client.CreateSecret(ctx, "my-enclave", &kms.CreateSecretRequest{Name: "db-password", Value: []byte("v3")}); !errors.Is(err, kms.ErrSecretExists) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, kms.ErrSecretExists)
+	}
+
+	secrets, err := client.GetSecret(ctx, "my-enclave",
+		&kms.SecretRequest{Name: "db-password"},
+		&kms.SecretRequest{Name: "db-password", Version: 1},
+	)
+	if err != nil {
+		t.Fatalf("Failed to get secrets: %v", err)
+	}
+	if v := secrets[0]; v.Version != 2 || !bytes.Equal(v.Value, []byte("v2")) {
+		t.Fatalf("Latest secret version mismatch: got '%d:%s' - want '%d:%s'", v.Version, v.Value, 2, "v2")
+	}
+	if v := secrets[1]; v.Version != 1 || !bytes.Equal(v.Value, []byte("v1")) {
+		t.Fatalf("Secret version mismatch: got '%d:%s' - want '%d:%s'", v.Version, v.Value, 1, "v1")
+	}
+
+	page, err := client.ListSecrets(ctx, &kms.ListRequest{Enclave: "my-enclave", Prefix: "db-"})
+	if err != nil {
+		t.Fatalf("Failed to list secrets: %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[0].Name != "db-password" || page.Items[0].Version != 2 || page.Items[1].Name != "db-user" {
+		t.Fatalf("Secret listing mismatch: got %v", page.Items)
+	}
+
+	if err = client.DeleteSecret(ctx, "my-enclave", &kms.DeleteSecretRequest{Name: "db-password"}); err != nil {
+		t.Fatalf("Failed to delete latest secret version: %v", err)
+	}
+	if _, err = client.GetSecret(ctx, "my-enclave", &kms.SecretRequest{Name: "db-password", Version: 2}); !errors.Is(err, kms.ErrSecretNotFound) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, kms.ErrSecretNotFound)
+	}
+	if secrets, err = client.GetSecret(ctx, "my-enclave", &kms.SecretRequest{Name: "db-password"}); err != nil || secrets[0].Version != 1 {
+		t.Fatalf("Failed to get remaining secret version: %v", err)
+	}
+	if err = client.DeleteSecret(ctx, "my-enclave", &kms.DeleteSecretRequest{Name: "app-token", AllVersions: true}); err != nil {
+		t.Fatalf("Failed to delete secret: %v", err)
+	}
+	if err = client.DeleteSecret(ctx, "my-enclave", &kms.DeleteSecretRequest{Name: "app-token"}); !errors.Is(err, kms.ErrSecretNotFound) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, kms.ErrSecretNotFound)
+	}
+}
+
+var createSecretTests = []struct {
+	Request    kms.CreateSecretRequest
+	ShouldFail bool
+}{
+	{Request: kms.CreateSecretRequest{Name: "db-password", Value: []byte("v1")}},                   // 0
+	{Request: kms.CreateSecretRequest{Name: "db-password", Value: []byte("v2"), AddVersion: true}}, // 1
+	{Request: kms.CreateSecretRequest{Name: "db-user", Value: []byte("admin")}},                    // 2
+	{Request: kms.CreateSecretRequest{Name: "app-token", Value: make([]byte, kms.MaxSecretSize)}},  // 3
+	{ // 4
+		Request:    kms.CreateSecretRequest{Name: "app-key", Value: make([]byte, kms.MaxSecretSize+1)},
+		ShouldFail: true,
+	},
+	{ // 5
+		Request:    kms.CreateSecretRequest{Name: "app-key"},
+		ShouldFail: true,
+	},
+	{ // 6
+		Request:    kms.CreateSecretRequest{Value: []byte("v1")},
+		ShouldFail: true,
+	},
+}
+
+func TestClient_Secrets_Policy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := kmstest.NewServer(nil)
+	defer server.Close()
+
+	client := kmstest.NewClient(t, server)
+	if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "my-enclave"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	for _, name := range []string{"db-password", "app-token"} {
+		if err := client.CreateSecret(ctx, "my-enclave", &kms.CreateSecretRequest{Name: name, Value: []byte(name)}); err != nil {
+			t.Fatalf("Failed to create secret '%s': %v", name, err)
+		}
+	}
+
+	key, err := mtls.GenerateKeyEdDSA(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate API key: %v", err)
+	}
+	if err = client.CreatePolicy(ctx, "my-enclave", &kms.CreatePolicyRequest{
+		Name:  "db-reader",
+		Allow: map[cmds.Command]kms.RuleSet{cmds.SecretGet: {"db-*": {}}},
+	}); err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+	if err = client.CreateIdentity(ctx, "my-enclave", &kms.CreateIdentityRequest{Identity: key.Identity(), Privilege: kms.User}); err != nil {
+		t.Fatalf("Failed to create identity: %v", err)
+	}
+	if err = client.AssignPolicy(ctx, "my-enclave", &kms.AssignPolicyRequest{Policy: "db-reader", Identity: key.Identity()}); err != nil {
+		t.Fatalf("Failed to assign policy: %v", err)
+	}
+
+	user := kmstest.NewClientWithKey(t, key, server)
+	if _, err = user.GetSecret(ctx, "my-enclave", &kms.SecretRequest{Name: "db-password"}); err != nil {
+		t.Fatalf("Failed to get secret: %v", err)
+	}
+	if _, err = user.GetSecret(ctx, "my-enclave", &kms.SecretRequest{Name: "app-token"}); !errors.Is(err, kms.ErrPermission) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, kms.ErrPermission)
+	}
+	if err = user.DeleteSecret(ctx, "my-enclave", &kms.DeleteSecretRequest{Name: "db-password"}); !errors.Is(err, kms.ErrPermission) {
+		t.Fatalf("Error mismatch: got '%v' - want '%v'", err, kms.ErrPermission)
+	}
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package slogcrypt
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/openstor/kms-go/kms"
+)
+
+// encryptedToken matches tokens of encrypted attribute values
+// and captures the key name, key version and ciphertext. Key
+// names may contain ':'. Since neither the key version nor the
+// ciphertext contain a ':', the greedy key name capture splits
+// the token at its last two ':' characters.
+var encryptedToken = regexp.MustCompile(regexp.QuoteMeta(PrefixEncrypted) + `([^\s"=]+):([0-9]+):([A-Za-z0-9_-]+)`)
+
+// Decoder re-hydrates log lines produced by a Handler by
+// replacing encrypted tokens with the decrypted attribute
+// values.
+//
+// The KMS identity of the Decoder's client must be allowed
+// to decrypt with the keys used by the Handler. Pseudonymized
+// tokens cannot be decrypted and are left as they are.
+type Decoder struct {
+	// Client is the KMS client used to decrypt tokens.
+	Client *kms.Client
+
+	// Enclave is the enclave containing the keys.
+	Enclave string
+
+	// Context is the encryption context. It must match
+	// the Config.Context of the Handler.
+	Context string
+}
+
+// Decode reads log lines from r, decodes them and writes
+// them to w. It stops at the first line that cannot be
+// decoded.
+func (d *Decoder) Decode(ctx context.Context, w io.Writer, r io.Reader) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			decoded, dErr := d.DecodeLine(ctx, line)
+			if dErr != nil {
+				return dErr
+			}
+			if _, wErr := w.Write(decoded); wErr != nil {
+				return wErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// DecodeLine returns a copy of the log line with all encrypted
+// tokens replaced by their decrypted values. All tokens within
+// the line are decrypted with a single KMS request.
+//
+// Lines produced by a slog.JSONHandler, starting with '{', have
+// decrypted values escaped as JSON string content. Otherwise,
+// decrypted values are quoted, like a slog.TextHandler does,
+// if they contain spaces or special characters.
+func (d *Decoder) DecodeLine(ctx context.Context, line []byte) ([]byte, error) {
+	matches := encryptedToken.FindAllSubmatchIndex(line, -1)
+	if len(matches) == 0 {
+		return line, nil
+	}
+
+	reqs := make([]*kms.DecryptRequest, 0, len(matches))
+	for _, m := range matches {
+		version, err := strconv.Atoi(string(line[m[4]:m[5]]))
+		if err != nil {
+			return nil, err
+		}
+		ciphertext, err := base64.RawURLEncoding.DecodeString(string(line[m[6]:m[7]]))
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, &kms.DecryptRequest{
+			Name:           string(line[m[2]:m[3]]),
+			Version:        version,
+			Ciphertext:     ciphertext,
+			AssociatedData: []byte(d.Context),
+		})
+	}
+
+	resps, err := d.Client.Decrypt(ctx, d.Enclave, reqs...)
+	if err != nil {
+		return nil, err
+	}
+
+	isJSON := bytes.HasPrefix(bytes.TrimSpace(line), []byte("{"))
+	decoded := make([]byte, 0, len(line))
+	var pos int
+	for i, m := range matches {
+		decoded = append(decoded, line[pos:m[0]]...)
+		if isJSON {
+			decoded = appendJSONString(decoded, string(resps[i].Plaintext))
+		} else {
+			decoded = appendTextValue(decoded, string(resps[i].Plaintext))
+		}
+		pos = m[1]
+	}
+	return append(decoded, line[pos:]...), nil
+}
+
+// appendJSONString appends s, escaped as JSON string
+// content without surrounding quotes, to b. Like a
+// slog.JSONHandler, it does not escape HTML characters.
+func appendJSONString(b []byte, s string) []byte {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s) // Encoding a string never fails
+
+	v := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	return append(b, v[1:len(v)-1]...)
+}
+
+// appendTextValue appends s to b. Like a slog.TextHandler,
+// it quotes s if it is empty or contains spaces, quotes,
+// '=' or non-printable characters.
+func appendTextValue(b []byte, s string) []byte {
+	needsQuoting := s == "" || strings.ContainsFunc(s, func(r rune) bool {
+		return r == '"' || r == '=' || unicode.IsSpace(r) || !unicode.IsPrint(r)
+	})
+	if needsQuoting {
+		return strconv.AppendQuote(b, s)
+	}
+	return append(b, s...)
+}
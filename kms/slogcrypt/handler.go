@@ -0,0 +1,322 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package slogcrypt implements a slog.Handler that encrypts
+// sensitive log attributes with a KMS key.
+//
+// Logs sometimes have to contain sensitive information, like
+// personal data or tokens, that should only be accessible to
+// authorized support engineers. A Handler replaces the values
+// of such attributes with tokens before passing the record on
+// to another handler:
+//
+//	handler, err := slogcrypt.NewHandler(slog.NewJSONHandler(os.Stderr, nil), &slogcrypt.Config{
+//		Client:  client,
+//		Enclave: "logs",
+//		Key:     "log-key",
+//		Keys:    []string{"email", "token"},
+//		Context: "service=payments",
+//	})
+//	if err != nil {
+//		// TODO: handle error
+//	}
+//	slog.New(handler).Info("user signed in", "email", "jane@example.com")
+//
+// In ModeEncrypt, the tokens can be decrypted by a Decoder using
+// a KMS client whose identity is allowed to decrypt with the key.
+// In ModePseudonymize, the tokens are MACs of the values computed
+// with a fixed key version. They cannot be decrypted but are stable,
+// such that log lines of the same user can be correlated, even
+// after the key has been rotated.
+package slogcrypt
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/openstor/kms-go/kms"
+)
+
+// Token prefixes of encrypted and pseudonymized attribute values.
+const (
+	PrefixEncrypted    = "kms-enc:v1:"
+	PrefixPseudonymous = "kms-mac:v1:"
+)
+
+// Redacted replaces an attribute value that could not be
+// encrypted or pseudonymized.
+const Redacted = "!REDACTED"
+
+// Mode controls how attribute values are replaced.
+type Mode uint
+
+// Supported modes.
+const (
+	// ModeEncrypt replaces attribute values with tokens
+	// containing the encrypted value.
+	ModeEncrypt Mode = iota
+
+	// ModePseudonymize replaces attribute values with
+	// tokens containing a MAC of the value.
+	ModePseudonymize
+)
+
+// Config is a structure containing configuration options
+// for Handlers.
+type Config struct {
+	// Client is the KMS client used to encrypt, resp.
+	// pseudonymize, attribute values.
+	Client *kms.Client
+
+	// Enclave is the enclave containing the Key.
+	Enclave string
+
+	// Key is the name of the KMS key. A Decoder can only
+	// decrypt tokens if the key name contains no spaces,
+	// quotes ('"') or '=' characters.
+	Key string
+
+	// Mode controls whether attribute values are
+	// encrypted or pseudonymized.
+	Mode Mode
+
+	// Version is the key version used to pseudonymize
+	// attribute values. It must be set in ModePseudonymize
+	// and is ignored otherwise. The latest key version
+	// changes when the key is rotated. Hence, MACs of the
+	// latest version would produce different pseudonyms
+	// for the same value before and after a rotation.
+	Version int
+
+	// Keys is a list of attribute keys. Attributes with one
+	// of these keys are replaced, including attributes within
+	// groups.
+	Keys []string
+
+	// Types is a list of Go types. Attributes whose values are
+	// of one of these types are replaced. For example, a custom
+	// type for access tokens.
+	Types []reflect.Type
+
+	// Context is the encryption context. It is crypto. bound
+	// to every token and the same context must be provided
+	// when decoding tokens. For example, the service name.
+	Context string
+}
+
+// NewHandler returns a new Handler that replaces matching
+// attributes and passes records on to the inner handler.
+//
+// It returns an error if the configuration has no Client or
+// Key, an invalid Mode, or no Version in ModePseudonymize.
+func NewHandler(inner slog.Handler, conf *Config) (*Handler, error) {
+	if conf.Client == nil {
+		return nil, errors.New("slogcrypt: no KMS client specified")
+	}
+	if conf.Key == "" {
+		return nil, errors.New("slogcrypt: no KMS key specified")
+	}
+	switch conf.Mode {
+	case ModeEncrypt:
+	case ModePseudonymize:
+		if conf.Version <= 0 {
+			return nil, errors.New("slogcrypt: no key version specified for pseudonymization")
+		}
+	default:
+		return nil, errors.New("slogcrypt: invalid mode '" + strconv.Itoa(int(conf.Mode)) + "'")
+	}
+
+	return &Handler{
+		inner:   inner,
+		client:  conf.Client,
+		enclave: conf.Enclave,
+		key:     conf.Key,
+		mode:    conf.Mode,
+		version: conf.Version,
+		keys:    slices.Clone(conf.Keys),
+		types:   slices.Clone(conf.Types),
+		context: conf.Context,
+	}, nil
+}
+
+// Handler is a slog.Handler that encrypts, or pseudonymizes,
+// sensitive attributes before passing records on to another
+// slog.Handler.
+//
+// If a value cannot be replaced, for example because the KMS
+// is not reachable, the Handler replaces it with Redacted and
+// returns the error once the inner handler has handled the
+// record. Hence, sensitive values are never logged in plain.
+type Handler struct {
+	inner   slog.Handler
+	client  *kms.Client
+	enclave string
+	key     string
+	mode    Mode
+	version int
+	keys    []string
+	types   []reflect.Type
+	context string
+}
+
+var _ slog.Handler = (*Handler)(nil) // compiler check
+
+// Enabled reports whether the inner handler handles
+// records at the given level.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+// Handle replaces all matching attributes of the record and
+// passes the resulting record on to the inner handler.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	attrs, err := h.replace(ctx, attrs)
+
+	record := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	record.AddAttrs(attrs...)
+	return errors.Join(h.inner.Handle(ctx, record), err)
+}
+
+// WithAttrs returns a new Handler whose inner handler has
+// the given attributes, with all matching attributes replaced.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	attrs, _ = h.replace(context.Background(), attrs) // Failed values are redacted
+	h2 := *h
+	h2.inner = h.inner.WithAttrs(attrs)
+	return &h2
+}
+
+// WithGroup returns a new Handler whose inner handler
+// has the given group.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	h2 := *h
+	h2.inner = h.inner.WithGroup(name)
+	return &h2
+}
+
+// replace returns a copy of attrs with all matching attributes
+// replaced by tokens. The values of all matching attributes are
+// sent to the KMS within one request.
+func (h *Handler) replace(ctx context.Context, attrs []slog.Attr) ([]slog.Attr, error) {
+	var values []*slog.Value
+	attrs = h.collect(attrs, &values)
+	if len(values) == 0 {
+		return attrs, nil
+	}
+
+	tokens, err := h.tokens(ctx, values)
+	for i, v := range values {
+		if err != nil {
+			*v = slog.StringValue(Redacted)
+		} else {
+			*v = slog.StringValue(tokens[i])
+		}
+	}
+	return attrs, err
+}
+
+// collect returns a copy of attrs and appends pointers to the
+// values of all matching attributes within the copy to values.
+func (h *Handler) collect(attrs []slog.Attr, values *[]*slog.Value) []slog.Attr {
+	attrs = slices.Clone(attrs)
+	for i := range attrs {
+		attrs[i].Value = attrs[i].Value.Resolve()
+
+		if attrs[i].Value.Kind() == slog.KindGroup {
+			attrs[i].Value = slog.GroupValue(h.collect(attrs[i].Value.Group(), values)...)
+			continue
+		}
+		if h.match(attrs[i]) {
+			*values = append(*values, &attrs[i].Value)
+		}
+	}
+	return attrs
+}
+
+// match reports whether the attribute should be replaced.
+func (h *Handler) match(a slog.Attr) bool {
+	if slices.Contains(h.keys, a.Key) {
+		return true
+	}
+	if len(h.types) > 0 && a.Value.Kind() == slog.KindAny {
+		return slices.Contains(h.types, reflect.TypeOf(a.Value.Any()))
+	}
+	return false
+}
+
+// tokens encrypts, resp. pseudonymizes, the values and
+// returns their tokens.
+func (h *Handler) tokens(ctx context.Context, values []*slog.Value) ([]string, error) {
+	tokens := make([]string, 0, len(values))
+	switch h.mode {
+	case ModeEncrypt:
+		reqs := make([]*kms.EncryptRequest, 0, len(values))
+		for _, v := range values {
+			reqs = append(reqs, &kms.EncryptRequest{
+				Name:           h.key,
+				Plaintext:      []byte(v.String()),
+				AssociatedData: []byte(h.context),
+			})
+		}
+		resps, err := h.client.Encrypt(ctx, h.enclave, reqs...)
+		if err != nil {
+			return nil, err
+		}
+		for _, resp := range resps {
+			tokens = append(tokens, formatToken(PrefixEncrypted, h.key, resp.Version, resp.Ciphertext))
+		}
+	case ModePseudonymize:
+		reqs := make([]*kms.MACRequest, 0, len(values))
+		for _, v := range values {
+			reqs = append(reqs, &kms.MACRequest{
+				Name:    h.key,
+				Version: h.version,
+				Message: macMessage(h.context, v.String()),
+			})
+		}
+		resps, err := h.client.MAC(ctx, h.enclave, reqs...)
+		if err != nil {
+			return nil, err
+		}
+		for _, resp := range resps {
+			tokens = append(tokens, formatToken(PrefixPseudonymous, h.key, resp.Version, resp.MAC))
+		}
+	default:
+		return nil, errors.New("slogcrypt: invalid mode '" + strconv.Itoa(int(h.mode)) + "'")
+	}
+	return tokens, nil
+}
+
+// macMessage returns the message for computing the MAC
+// of value bound to the encryption context.
+func macMessage(context, value string) []byte {
+	msg := make([]byte, 0, 4+len(context)+len(value))
+	msg = strconv.AppendInt(msg, int64(len(context)), 10)
+	msg = append(msg, ':')
+	msg = append(msg, context...)
+	return append(msg, value...)
+}
+
+// formatToken returns the token <prefix><key>:<version>:<data>.
+func formatToken(prefix, key string, version int, data []byte) string {
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	sb.WriteString(key)
+	sb.WriteByte(':')
+	sb.WriteString(strconv.Itoa(version))
+	sb.WriteByte(':')
+	sb.WriteString(base64.RawURLEncoding.EncodeToString(data))
+	return sb.String()
+}
@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package slogcrypt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openstor/kms-go/kms"
+	"github.com/openstor/kms-go/kms/kmstest"
+)
+
+type accessToken string
+
+func TestHandler_Encrypt(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, "log-key")
+	for i, test := range handlerTests {
+		var buf bytes.Buffer
+		h := newTestHandler(t, slog.NewJSONHandler(&buf, nil), client, ModeEncrypt, "service=test")
+
+		logger := slog.New(h)
+		if test.Group != "" {
+			logger = logger.WithGroup(test.Group)
+		}
+		logger.Info("test", test.Args...)
+
+		line := buf.String()
+		for _, secret := range test.Secrets {
+			if strings.Contains(line, secret) {
+				t.Fatalf("Test %d: log line contains plain value '%s': %s", i, secret, line)
+			}
+		}
+		if n := strings.Count(line, PrefixEncrypted); n != len(test.Secrets) {
+			t.Fatalf("Test %d: token count mismatch: got %d - want %d", i, n, len(test.Secrets))
+		}
+
+		d := &Decoder{Client: client, Enclave: "logs", Context: "service=test"}
+		decoded, err := d.DecodeLine(context.Background(), buf.Bytes())
+		if err != nil {
+			t.Fatalf("Test %d: failed to decode log line: %v", i, err)
+		}
+		if !json.Valid(decoded) {
+			t.Fatalf("Test %d: decoded log line is not valid JSON: %s", i, decoded)
+		}
+		for _, secret := range test.Secrets {
+			if !bytes.Contains(decoded, []byte(secret)) {
+				t.Fatalf("Test %d: decoded log line does not contain '%s': %s", i, secret, decoded)
+			}
+		}
+
+		d.Context = "service=other"
+		if _, err = d.DecodeLine(context.Background(), buf.Bytes()); err == nil && len(test.Secrets) > 0 {
+			t.Fatalf("Test %d: decoded log line with wrong encryption context", i)
+		}
+	}
+}
+
+var handlerTests = []struct {
+	Args    []any
+	Group   string
+	Secrets []string
+}{
+	{Args: []any{"user", "jane"}},
+	{Args: []any{"email", "jane@example.com"}, Secrets: []string{"jane@example.com"}},
+	{Args: []any{"email", "jane@example.com", "token", accessToken("s3cr3t")}, Secrets: []string{"jane@example.com", "s3cr3t"}},
+	{Args: []any{slog.Group("req", "email", `"jane" <jane@example.com>`)}, Secrets: []string{`\"jane\" <jane@example.com>`}},
+	{Args: []any{"email", "jane@example.com"}, Group: "req", Secrets: []string{"jane@example.com"}},
+}
+
+func TestHandler_Pseudonymize(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, "log-key")
+	var buf bytes.Buffer
+	logger := slog.New(newTestHandler(t, slog.NewTextHandler(&buf, nil), client, ModePseudonymize, "service=test"))
+
+	logger.Info("first", "email", "jane@example.com")
+	logger.Info("second", "email", "john@example.com")
+	if err := client.CreateKey(context.Background(), "logs", &kms.CreateKeyRequest{Name: "log-key", AddVersion: true}); err != nil {
+		t.Fatalf("Failed to rotate key: %v", err)
+	}
+	logger.Info("third", "email", "jane@example.com")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Line count mismatch: got %d - want %d", len(lines), 3)
+	}
+	tokens := make([]string, 0, len(lines))
+	for _, line := range lines {
+		_, token, ok := strings.Cut(line, "email=")
+		if !ok || !strings.HasPrefix(token, PrefixPseudonymous+"log-key:1:") {
+			t.Fatalf("Log line contains no pseudonymized token of key version 1: %s", line)
+		}
+		tokens = append(tokens, token)
+	}
+	if tokens[0] != tokens[2] {
+		t.Fatalf("Tokens of equal values differ after key rotation: '%s' != '%s'", tokens[0], tokens[2])
+	}
+	if tokens[0] == tokens[1] {
+		t.Fatalf("Tokens of different values are equal: '%s'", tokens[0])
+	}
+}
+
+func TestHandler_Redact(t *testing.T) {
+	t.Parallel()
+
+	server := kmstest.NewServer(nil)
+	client := kmstest.NewClient(t, server)
+	server.Close()
+
+	var buf bytes.Buffer
+	h := newTestHandler(t, slog.NewTextHandler(&buf, nil), client, ModeEncrypt, "")
+
+	r := slog.NewRecord(time.Time{}, slog.LevelInfo, "test", 0)
+	r.AddAttrs(slog.String("email", "jane@example.com"))
+	if err := h.Handle(context.Background(), r); err == nil {
+		t.Fatal("Handling record succeeded with unreachable KMS server")
+	}
+	if line := buf.String(); !strings.Contains(line, "email="+Redacted) {
+		t.Fatalf("Log line does not contain redacted value: %s", line)
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range newHandlerTests {
+		_, err := NewHandler(slog.DiscardHandler, &test.Config)
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: creating handler should have failed", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to create handler: %v", i, err)
+		}
+	}
+}
+
+var newHandlerTests = []struct {
+	Config     Config
+	ShouldFail bool
+}{
+	{Config: Config{Client: &kms.Client{}, Key: "log-key"}},                                     // 0
+	{Config: Config{Client: &kms.Client{}, Key: "log-key", Mode: ModePseudonymize, Version: 1}}, // 1
+	{Config: Config{Client: &kms.Client{}, Key: "log-key", Version: -1}},                        // 2 - Version is ignored in ModeEncrypt
+
+	{Config: Config{Key: "log-key"}, ShouldFail: true},                                                // 3
+	{Config: Config{Client: &kms.Client{}}, ShouldFail: true},                                         // 4
+	{Config: Config{Client: &kms.Client{}, Key: "log-key", Mode: ModePseudonymize}, ShouldFail: true}, // 5
+	{Config: Config{Client: &kms.Client{}, Key: "log-key", Mode: 2}, ShouldFail: true},                // 6
+}
+
+func TestDecoder_Text(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, "log-key")
+	var buf bytes.Buffer
+	logger := slog.New(newTestHandler(t, slog.NewTextHandler(&buf, nil), client, ModeEncrypt, ""))
+	logger.Info("first", "email", "jane@example.com")
+	logger.Info("second", "name", "Jane Doe", "email", "jane doe@example.com")
+
+	var out bytes.Buffer
+	d := &Decoder{Client: client, Enclave: "logs"}
+	if err := d.Decode(context.Background(), &out, &buf); err != nil {
+		t.Fatalf("Failed to decode log lines: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Line count mismatch: got %d - want %d", len(lines), 2)
+	}
+	if !strings.HasSuffix(lines[0], " email=jane@example.com") {
+		t.Fatalf("Decoded line mismatch: %s", lines[0])
+	}
+	if !strings.HasSuffix(lines[1], ` name="Jane Doe" email="jane doe@example.com"`) {
+		t.Fatalf("Decoded line mismatch: %s", lines[1])
+	}
+}
+
+func TestDecoder_KeyName(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"team:log-key", "team:1:log-key", "log-key:1"}
+	client := newTestClient(t, names...)
+	for i, name := range names {
+		var buf bytes.Buffer
+		h, err := NewHandler(slog.NewJSONHandler(&buf, nil), &Config{
+			Client:  client,
+			Enclave: "logs",
+			Key:     name,
+			Keys:    []string{"email"},
+		})
+		if err != nil {
+			t.Fatalf("Test %d: failed to create handler: %v", i, err)
+		}
+		slog.New(h).Info("login", "email", "jane@example.com")
+
+		d := &Decoder{Client: client, Enclave: "logs"}
+		line, err := d.DecodeLine(context.Background(), buf.Bytes())
+		if err != nil {
+			t.Fatalf("Test %d: failed to decode log line: %v", i, err)
+		}
+		if !bytes.Contains(line, []byte(`"email":"jane@example.com"`)) {
+			t.Fatalf("Test %d: decoded line mismatch: %s", i, line)
+		}
+	}
+}
+
+func newTestHandler(t *testing.T, inner slog.Handler, client *kms.Client, mode Mode, context string) *Handler {
+	h, err := NewHandler(inner, &Config{
+		Client:  client,
+		Enclave: "logs",
+		Key:     "log-key",
+		Mode:    mode,
+		Version: 1,
+		Keys:    []string{"email", "name"},
+		Types:   []reflect.Type{reflect.TypeFor[accessToken]()},
+		Context: context,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create handler: %v", err)
+	}
+	return h
+}
+
+// newTestClient starts a fake KMS server with the enclave
+// "logs" containing the given keys. It returns a client for
+// the server and shuts the server down once the test ends.
+func newTestClient(t *testing.T, keys ...string) *kms.Client {
+	server := kmstest.NewServer(nil)
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := kmstest.NewClient(t, server)
+	if err := client.CreateEnclave(ctx, &kms.CreateEnclaveRequest{Name: "logs"}); err != nil {
+		t.Fatalf("Failed to create enclave: %v", err)
+	}
+	for _, key := range keys {
+		if err := client.CreateKey(ctx, "logs", &kms.CreateKeyRequest{Name: key}); err != nil {
+			t.Fatalf("Failed to create key '%s': %v", key, err)
+		}
+	}
+	return client
+}
@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package spiffe implements client credentials based on SPIFFE
+// X.509 SVIDs.
+//
+// A Source fetches X.509 SVIDs from the SPIFFE Workload API and
+// rotates them automatically. Its TLS configuration can be used
+// by KMS and KES clients instead of long-lived API keys:
+//
+//	src, err := spiffe.New(ctx, &spiffe.Config{
+//		Addr: "unix:///run/spire/agent.sock",
+//	})
+//	if err != nil {
+//		// handle error
+//	}
+//	defer src.Close()
+//
+//	client, err := kms.NewClient(&kms.Config{
+//		Endpoints: endpoints,
+//		TLS:       src.TLSConfig(),
+//	})
+//
+// or, for KES:
+//
+//	client := kes.NewClientWithConfig(endpoint, src.TLSConfig())
+package spiffe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"aead.dev/mtls"
+)
+
+// EnvEndpointSocket is the environment variable containing the
+// default SPIFFE Workload API endpoint.
+const EnvEndpointSocket = "SPIFFE_ENDPOINT_SOCKET"
+
+// Config is a structure containing configuration options
+// for SPIFFE credential sources.
+type Config struct {
+	// Addr is the SPIFFE Workload API endpoint. It must be a
+	// Unix socket address. For example, "unix:///tmp/agent.sock".
+	//
+	// If empty, the value of the SPIFFE_ENDPOINT_SOCKET
+	// environment variable is used.
+	Addr string
+
+	// VerifyServer controls whether the TLS configuration returned
+	// by Source.TLSConfig verifies server certificates against the
+	// SPIFFE trust bundle instead of the system root CAs.
+	//
+	// If true, servers must present an X.509 SVID issued within the
+	// trust domain of the Source's SVID. Refer to ServerIDs.
+	VerifyServer bool
+
+	// ServerIDs is an optional list of SPIFFE IDs that are accepted
+	// as server identities when VerifyServer is true. If empty, any
+	// SPIFFE ID within the trust domain of the Source's SVID is
+	// accepted.
+	ServerIDs []string
+
+	// ErrorLog is an optional function called when fetching new
+	// X.509 SVIDs fails. The Source keeps using the current SVID
+	// and retries in the background.
+	ErrorLog func(error)
+}
+
+// New returns a new Source that fetches X.509 SVIDs from the
+// SPIFFE Workload API. It blocks until it has received the first
+// X.509 SVID or ctx is done.
+//
+// The Source keeps a connection to the Workload API open and
+// rotates its X.509 SVID whenever the Workload API sends a new
+// one. It must be closed to release associated resources.
+func New(ctx context.Context, conf *Config) (*Source, error) {
+	addr := conf.Addr
+	if addr == "" {
+		addr = os.Getenv(EnvEndpointSocket)
+	}
+	if addr == "" {
+		return nil, errors.New("spiffe: no workload API address provided")
+	}
+	path, err := parseAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range conf.ServerIDs {
+		if _, err := parseID(id); err != nil {
+			return nil, err
+		}
+	}
+
+	client := newWorkloadClient(path)
+	streamCtx, cancel := context.WithCancel(context.Background())
+
+	// The stream must outlive ctx. Hence, we only use ctx
+	// to limit how long we wait for the first SVID.
+	type result struct {
+		stream *x509SVIDStream
+		svid   *svid
+		err    error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		stream, err := fetchX509SVID(streamCtx, client)
+		if err != nil {
+			ch <- result{err: err}
+			return
+		}
+		v, err := stream.Next()
+		if err != nil {
+			stream.Close()
+			ch <- result{err: err}
+			return
+		}
+		ch <- result{stream: stream, svid: v}
+	}()
+
+	var res result
+	select {
+	case <-ctx.Done():
+		cancel()
+		return nil, context.Cause(ctx)
+	case res = <-ch:
+	}
+	if res.err != nil {
+		cancel()
+		return nil, res.err
+	}
+
+	s := &Source{
+		client:       client,
+		svid:         res.svid,
+		serverIDs:    slices.Clone(conf.ServerIDs),
+		verifyServer: conf.VerifyServer,
+		errorLog:     conf.ErrorLog,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}
+	go s.watch(streamCtx, res.stream)
+	return s, nil
+}
+
+// Source is a source of X.509 SVIDs. It keeps the X.509 SVID
+// up-to-date and can be used concurrently.
+type Source struct {
+	client       *http.Client
+	serverIDs    []string
+	verifyServer bool
+	errorLog     func(error)
+
+	mu   sync.RWMutex
+	svid *svid
+
+	cancel    context.CancelFunc
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// ID returns the SPIFFE ID of the current X.509 SVID.
+// For example, "spiffe://example.org/workload".
+func (s *Source) ID() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.svid.ID
+}
+
+// Identity returns the mTLS identity of the current X.509
+// SVID. It changes whenever the SVID key pair is rotated.
+func (s *Source) Identity() mtls.Identity {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.svid.Identity
+}
+
+// Bundle returns the X.509 trust bundle of the current
+// X.509 SVID's trust domain.
+func (s *Source) Bundle() *x509.CertPool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.svid.Bundle.Clone()
+}
+
+// GetClientCertificate returns the current X.509 SVID as TLS
+// client certificate. It can be used as tls.Config.GetClientCertificate.
+func (s *Source) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cert := s.svid.Certificate
+	return &cert, nil
+}
+
+// TLSConfig returns a new TLS client configuration that uses the
+// current X.509 SVID as client certificate. If Config.VerifyServer
+// is true, it verifies server certificates against the SPIFFE trust
+// bundle instead of the system root CAs.
+func (s *Source) TLSConfig() *tls.Config {
+	conf := &tls.Config{
+		MinVersion:           tls.VersionTLS12,
+		GetClientCertificate: s.GetClientCertificate,
+	}
+	if s.verifyServer {
+		// SPIFFE servers identify themselves by SPIFFE ID (URI SAN)
+		// rather than by hostname. Hence, we disable the default
+		// hostname verification and verify the certificate chain
+		// and SPIFFE ID ourselves.
+		conf.InsecureSkipVerify = true
+		conf.VerifyPeerCertificate = s.verifyPeerCertificate
+	}
+	return conf
+}
+
+// Close closes the connection to the Workload API. The Source
+// keeps returning the last X.509 SVID.
+func (s *Source) Close() error {
+	s.closeOnce.Do(func() {
+		s.cancel()
+		<-s.done
+	})
+	return nil
+}
+
+// watch reads new X.509 SVIDs from the stream until ctx is done.
+// It re-opens the stream whenever it breaks.
+func (s *Source) watch(ctx context.Context, stream *x509SVIDStream) {
+	defer close(s.done)
+
+	const (
+		MinBackoff = 1 * time.Second
+		MaxBackoff = 30 * time.Second
+	)
+	backoff := MinBackoff
+	for {
+		if stream != nil {
+			for {
+				v, err := stream.Next()
+				if err != nil {
+					if ctx.Err() == nil {
+						s.logError(err)
+					}
+					break
+				}
+
+				s.mu.Lock()
+				s.svid = v
+				s.mu.Unlock()
+				backoff = MinBackoff
+			}
+			stream.Close()
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		backoff = min(2*backoff, MaxBackoff)
+
+		var err error
+		if stream, err = fetchX509SVID(ctx, s.client); err != nil {
+			if ctx.Err() == nil {
+				s.logError(err)
+			}
+			stream = nil
+		}
+	}
+}
+
+func (s *Source) logError(err error) {
+	if s.errorLog != nil {
+		s.errorLog(err)
+	}
+}
+
+// verifyPeerCertificate verifies that rawCerts is an X.509 SVID chain
+// that has been issued by the current trust bundle and that its SPIFFE
+// ID is an accepted server ID.
+func (s *Source) verifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errors.New("spiffe: no server certificate provided")
+	}
+
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("spiffe: invalid server certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs[0].URIs) != 1 {
+		return errors.New("spiffe: server certificate is not an X.509 SVID")
+	}
+
+	s.mu.RLock()
+	bundle, trustDomain := s.svid.Bundle, s.svid.TrustDomain
+	s.mu.RUnlock()
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         bundle,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("spiffe: failed to verify server certificate: %v", err)
+	}
+
+	id := certs[0].URIs[0].String()
+	serverTrustDomain, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if len(s.serverIDs) > 0 {
+		if !slices.Contains(s.serverIDs, id) {
+			return fmt.Errorf("spiffe: server ID '%s' is not accepted", id)
+		}
+		return nil
+	}
+	if !strings.EqualFold(serverTrustDomain, trustDomain) {
+		return fmt.Errorf("spiffe: server ID '%s' is not within trust domain '%s'", id, trustDomain)
+	}
+	return nil
+}
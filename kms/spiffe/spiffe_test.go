@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package spiffe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"aead.dev/mtls"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestSource(t *testing.T) {
+	t.Parallel()
+
+	ca := newTestCA(t, "example.org")
+	first := ca.Issue(t, "spiffe://example.org/client")
+	second := ca.Issue(t, "spiffe://example.org/client")
+
+	rotate := make(chan struct{})
+	addr := startWorkloadAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGRPCMessage(w, first)
+		<-rotate
+		writeGRPCMessage(w, second)
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	src, err := New(ctx, &Config{
+		Addr:         addr,
+		VerifyServer: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create SPIFFE source: %v", err)
+	}
+	defer src.Close()
+
+	if id := src.ID(); id != "spiffe://example.org/client" {
+		t.Fatalf("SPIFFE ID mismatch: got '%s' - want '%s'", id, "spiffe://example.org/client")
+	}
+	firstIdentity := src.Identity()
+	if firstIdentity.IsZero() {
+		t.Fatal("Identity of X.509 SVID is empty")
+	}
+	cert, err := src.TLSConfig().GetClientCertificate(nil)
+	if err != nil {
+		t.Fatalf("Failed to get client certificate: %v", err)
+	}
+	if id := mtls.CertificateIdentity(cert.Leaf); id != firstIdentity {
+		t.Fatalf("Identity mismatch: got '%v' - want '%v'", id, firstIdentity)
+	}
+
+	close(rotate)
+	for src.Identity() == firstIdentity {
+		select {
+		case <-ctx.Done():
+			t.Fatal("X.509 SVID has not been rotated")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestSource_VerifyServer(t *testing.T) {
+	t.Parallel()
+
+	ca := newTestCA(t, "example.org")
+	other := newTestCA(t, "example.org")
+	client := ca.Issue(t, "spiffe://example.org/client")
+
+	addr := startWorkloadAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		writeGRPCMessage(w, client)
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for i, test := range verifyServerTests {
+		src, err := New(ctx, &Config{
+			Addr:         addr,
+			VerifyServer: true,
+			ServerIDs:    test.ServerIDs,
+		})
+		if err != nil {
+			t.Fatalf("Test %d: failed to create SPIFFE source: %v", i, err)
+		}
+
+		issuer := ca
+		if test.OtherCA {
+			issuer = other
+		}
+		server := issuer.Issue(t, test.ServerID)
+
+		err = src.TLSConfig().VerifyPeerCertificate([][]byte{server.Cert.Raw}, nil)
+		src.Close()
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: should have failed to verify server certificate", i)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to verify server certificate: %v", i, err)
+		}
+	}
+}
+
+var verifyServerTests = []struct {
+	ServerID   string
+	ServerIDs  []string
+	OtherCA    bool
+	ShouldFail bool
+}{
+	{ServerID: "spiffe://example.org/kms"},
+	{ServerID: "spiffe://example.org/kms", ServerIDs: []string{"spiffe://example.org/kms"}},
+	{ServerID: "spiffe://example.org/kes", ServerIDs: []string{"spiffe://example.org/kms"}, ShouldFail: true},
+	{ServerID: "spiffe://example.com/kms", ShouldFail: true},
+	{ServerID: "spiffe://example.org/kms", OtherCA: true, ShouldFail: true},
+}
+
+func TestParseAddr(t *testing.T) {
+	t.Parallel()
+
+	for i, test := range parseAddrTests {
+		path, err := parseAddr(test.Addr)
+		if err == nil && test.ShouldFail {
+			t.Fatalf("Test %d: should have failed to parse '%s'", i, test.Addr)
+		}
+		if err != nil && !test.ShouldFail {
+			t.Fatalf("Test %d: failed to parse '%s': %v", i, test.Addr, err)
+		}
+		if path != test.Path {
+			t.Fatalf("Test %d: path mismatch: got '%s' - want '%s'", i, path, test.Path)
+		}
+	}
+}
+
+var parseAddrTests = []struct {
+	Addr       string
+	Path       string
+	ShouldFail bool
+}{
+	{Addr: "unix:///tmp/agent.sock", Path: "/tmp/agent.sock"},
+	{Addr: "unix:/tmp/agent.sock", Path: "/tmp/agent.sock"},
+	{Addr: "unix:agent.sock", Path: "agent.sock"},
+	{Addr: "tcp://127.0.0.1:8081", ShouldFail: true},
+	{Addr: "unix://host/tmp/agent.sock", ShouldFail: true},
+	{Addr: "unix://", ShouldFail: true},
+	{Addr: "/tmp/agent.sock", ShouldFail: true},
+}
+
+// startWorkloadAPI starts a fake SPIFFE Workload API server on a
+// Unix socket that handles FetchX509SVID requests with the given
+// handler. It returns the server's address.
+func startWorkloadAPI(t *testing.T, handler http.HandlerFunc) string {
+	dir, err := os.MkdirTemp("", "spiffe") // Unix socket paths must be short
+	if err != nil {
+		t.Fatalf("Failed to create temp. directory: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "agent.sock")
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("Failed to listen on '%s': %v", path, err)
+	}
+
+	var protocols http.Protocols
+	protocols.SetUnencryptedHTTP2(true)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathFetchX509SVID, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(headerWorkloadAPI) != "true" {
+			w.Header().Set(headerContentType, contentTypeGRPC)
+			w.Header().Set(headerGRPCStatus, "3") // InvalidArgument
+			w.Header().Set(headerGRPCMessage, url.PathEscape("security header missing from request"))
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.Header().Set(headerContentType, contentTypeGRPC)
+		w.Header().Set("Trailer", headerGRPCStatus)
+		w.WriteHeader(http.StatusOK)
+
+		handler(w, r)
+		w.Header().Set(headerGRPCStatus, "0")
+	})
+	server := &http.Server{
+		Handler:   mux,
+		Protocols: &protocols,
+	}
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+
+	return "unix://" + path
+}
+
+// writeGRPCMessage writes the X509SVIDResponse containing the
+// SVID as gRPC message to w.
+func writeGRPCMessage(w http.ResponseWriter, svid *testSVID) {
+	var msg []byte
+	msg = protowire.AppendTag(msg, 1, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, svid.Marshal())
+
+	var header [5]byte
+	binary.BigEndian.PutUint32(header[1:], uint32(len(msg)))
+	w.Write(header[:])
+	w.Write(msg)
+	w.(http.Flusher).Flush()
+}
+
+type testCA struct {
+	Cert *x509.Certificate
+	Key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, trustDomain string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate CA key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: trustDomain},
+		URIs:                  []*url.URL{{Scheme: "spiffe", Host: trustDomain}},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(raw)
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificate: %v", err)
+	}
+	return &testCA{Cert: cert, Key: key}
+}
+
+type testSVID struct {
+	ID   string
+	CA   *x509.Certificate
+	Cert *x509.Certificate
+	Key  []byte
+}
+
+func (ca *testCA) Issue(t *testing.T, id string) *testSVID {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate SVID key: %v", err)
+	}
+	u, err := url.Parse(id)
+	if err != nil {
+		t.Fatalf("Failed to parse SPIFFE ID: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		URIs:         []*url.URL{u},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, tmpl, ca.Cert, key.Public(), ca.Key)
+	if err != nil {
+		t.Fatalf("Failed to create SVID: %v", err)
+	}
+	cert, err := x509.ParseCertificate(raw)
+	if err != nil {
+		t.Fatalf("Failed to parse SVID: %v", err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to encode SVID key: %v", err)
+	}
+	return &testSVID{ID: id, CA: ca.Cert, Cert: cert, Key: pkcs8}
+}
+
+// Marshal returns the X509SVID protobuf message.
+func (s *testSVID) Marshal() []byte {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	b = protowire.AppendString(b, s.ID)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendBytes(b, s.Cert.Raw)
+	b = protowire.AppendTag(b, 3, protowire.BytesType)
+	b = protowire.AppendBytes(b, s.Key)
+	b = protowire.AppendTag(b, 4, protowire.BytesType)
+	b = protowire.AppendBytes(b, s.CA.Raw)
+	return b
+}
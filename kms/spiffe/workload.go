@@ -0,0 +1,306 @@
+// SPDX-FileCopyrightText: 2025 openstor contributors
+// SPDX-FileCopyrightText: 2015-2025 MinIO, Inc.
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package spiffe
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"aead.dev/mtls"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The SPIFFE Workload API is a gRPC API. Instead of depending
+// on a gRPC implementation, the client speaks the gRPC wire
+// protocol directly: HTTP/2 without TLS (h2c) over a Unix socket
+// and length-prefixed protobuf messages.
+const (
+	pathFetchX509SVID = "/SpiffeWorkloadAPI/FetchX509SVID"
+
+	headerContentType  = "Content-Type"
+	headerTE           = "Te"
+	headerGRPCStatus   = "Grpc-Status"
+	headerGRPCMessage  = "Grpc-Message"
+	headerWorkloadAPI  = "Workload.spiffe.io"
+	contentTypeGRPC    = "application/grpc"
+	maxGRPCMessageSize = 4 << 20
+)
+
+// parseAddr returns the path of the Unix socket referenced by the
+// Workload API endpoint addr. For example, "unix:///tmp/agent.sock".
+func parseAddr(addr string) (string, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return "", fmt.Errorf("spiffe: invalid workload API address '%s': %v", addr, err)
+	}
+	if u.Scheme != "unix" {
+		return "", fmt.Errorf("spiffe: invalid workload API address '%s': scheme must be 'unix'", addr)
+	}
+	if u.Host != "" || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", fmt.Errorf("spiffe: invalid workload API address '%s'", addr)
+	}
+
+	path := u.Path
+	if path == "" {
+		path = u.Opaque
+	}
+	if path == "" {
+		return "", fmt.Errorf("spiffe: invalid workload API address '%s': socket path is empty", addr)
+	}
+	return path, nil
+}
+
+// newWorkloadClient returns a new HTTP client that sends h2c
+// requests to the Unix socket at path.
+func newWorkloadClient(path string) *http.Client {
+	var protocols http.Protocols
+	protocols.SetUnencryptedHTTP2(true)
+
+	return &http.Client{
+		Transport: &http.Transport{
+			Protocols: &protocols,
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", path)
+			},
+		},
+	}
+}
+
+// x509SVIDStream is a stream of X.509 SVID responses sent by the
+// Workload API. The Workload API sends a new response whenever
+// the SVIDs or trust bundles of the workload change.
+type x509SVIDStream struct {
+	resp *http.Response
+}
+
+// fetchX509SVID opens a new X.509 SVID stream.
+func fetchX509SVID(ctx context.Context, client *http.Client) (*x509SVIDStream, error) {
+	// A gRPC message is prefixed with a compression flag and its
+	// length. The X509SVIDRequest is an empty protobuf message.
+	body := []byte{0, 0, 0, 0, 0}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://localhost"+pathFetchX509SVID, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	req.Header.Set(headerContentType, contentTypeGRPC)
+	req.Header.Set(headerTE, "trailers")
+	req.Header.Set(headerWorkloadAPI, "true")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("spiffe: workload API returned '%s'", resp.Status)
+	}
+	if err = grpcStatus(resp.Header); err != nil { // Trailers-only response
+		resp.Body.Close()
+		return nil, err
+	}
+	return &x509SVIDStream{resp: resp}, nil
+}
+
+// Next returns the next X.509 SVID from the stream.
+func (s *x509SVIDStream) Next() (*svid, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(s.resp.Body, header[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			if err := grpcStatus(s.resp.Trailer); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	if header[0] != 0 {
+		return nil, errors.New("spiffe: compressed workload API messages are not supported")
+	}
+
+	n := binary.BigEndian.Uint32(header[1:])
+	if n > maxGRPCMessageSize {
+		return nil, errors.New("spiffe: workload API message too large")
+	}
+	msg := make([]byte, n)
+	if _, err := io.ReadFull(s.resp.Body, msg); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return parseX509SVIDResponse(msg)
+}
+
+// Close closes the stream.
+func (s *x509SVIDStream) Close() error { return s.resp.Body.Close() }
+
+// grpcStatus returns an error if h contains a non-zero gRPC
+// status code.
+func grpcStatus(h http.Header) error {
+	status := h.Get(headerGRPCStatus)
+	if status == "" || status == "0" {
+		return nil
+	}
+
+	msg, _ := url.PathUnescape(h.Get(headerGRPCMessage))
+	if msg == "" {
+		return fmt.Errorf("spiffe: workload API returned gRPC status %s", status)
+	}
+	return fmt.Errorf("spiffe: workload API returned gRPC status %s: %s", status, msg)
+}
+
+// svid is an X.509 SVID and the trust bundle of its trust domain.
+type svid struct {
+	ID          string // The SPIFFE ID. For example, spiffe://example.org/workload
+	TrustDomain string // The trust domain of the SPIFFE ID. For example, example.org
+
+	Certificate tls.Certificate
+	Identity    mtls.Identity
+	Bundle      *x509.CertPool
+}
+
+// parseX509SVIDResponse parses the protobuf message:
+//
+//	message X509SVIDResponse {
+//	  repeated X509SVID svids = 1;
+//	  repeated bytes crl = 2;
+//	  map<string, bytes> federated_bundles = 3;
+//	}
+//
+// and returns the first, default, X.509 SVID.
+func parseX509SVIDResponse(b []byte) (*svid, error) {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if num == 1 && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			return parseX509SVID(v)
+		}
+		if n = protowire.ConsumeFieldValue(num, typ, b); n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil, errors.New("spiffe: workload API response contains no X.509 SVID")
+}
+
+// parseX509SVID parses the protobuf message:
+//
+//	message X509SVID {
+//	  string spiffe_id = 1;
+//	  bytes x509_svid = 2;
+//	  bytes x509_svid_key = 3;
+//	  bytes bundle = 4;
+//	  string hint = 5;
+//	}
+func parseX509SVID(b []byte) (*svid, error) {
+	var id string
+	var chain, key, bundle []byte
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if typ != protowire.BytesType || num < 1 || num > 4 {
+			if n = protowire.ConsumeFieldValue(num, typ, b); n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			b = b[n:]
+			continue
+		}
+
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		switch num {
+		case 1:
+			id = string(v)
+		case 2:
+			chain = v
+		case 3:
+			key = v
+		case 4:
+			bundle = v
+		}
+	}
+
+	trustDomain, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	certs, err := x509.ParseCertificates(chain)
+	if err != nil {
+		return nil, fmt.Errorf("spiffe: invalid X.509 SVID: %v", err)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("spiffe: invalid X.509 SVID: no certificate")
+	}
+	privateKey, err := x509.ParsePKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("spiffe: invalid X.509 SVID private key: %v", err)
+	}
+	roots, err := x509.ParseCertificates(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("spiffe: invalid X.509 trust bundle: %v", err)
+	}
+
+	cert := tls.Certificate{
+		PrivateKey: privateKey,
+		Leaf:       certs[0],
+	}
+	for _, c := range certs {
+		cert.Certificate = append(cert.Certificate, c.Raw)
+	}
+	pool := x509.NewCertPool()
+	for _, root := range roots {
+		pool.AddCert(root)
+	}
+	return &svid{
+		ID:          id,
+		TrustDomain: trustDomain,
+		Certificate: cert,
+		Identity:    mtls.CertificateIdentity(certs[0]),
+		Bundle:      pool,
+	}, nil
+}
+
+// parseID parses id as SPIFFE ID and returns its trust domain.
+func parseID(id string) (string, error) {
+	const Scheme = "spiffe://"
+	if !strings.HasPrefix(id, Scheme) {
+		return "", fmt.Errorf("spiffe: invalid SPIFFE ID '%s'", id)
+	}
+
+	trustDomain, _, _ := strings.Cut(strings.TrimPrefix(id, Scheme), "/")
+	if trustDomain == "" {
+		return "", fmt.Errorf("spiffe: invalid SPIFFE ID '%s': empty trust domain", id)
+	}
+	return trustDomain, nil
+}